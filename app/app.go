@@ -15,7 +15,7 @@ import (
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
 	v0 "github.com/Dipper-Labs/Dipper-Protocol/app/v0"
-	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
 	"github.com/Dipper-Labs/Dipper-Protocol/baseapp"
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -101,6 +101,10 @@ func NewDIPApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	}
 
 	engine.Add(v0.NewProtocolV0(0, logger, protocolKeeper, app.DeliverTx, invCheckPeriod, nil))
+	engine.Add(v0.NewProtocolV0(v0.EthCompatibleVersion, logger, protocolKeeper, app.DeliverTx, invCheckPeriod, nil))
+	engine.Add(v0.NewProtocolV0(v0.BerlinVersion, logger, protocolKeeper, app.DeliverTx, invCheckPeriod, nil))
+	engine.Add(v0.NewProtocolV0(v0.CancunVersion, logger, protocolKeeper, app.DeliverTx, invCheckPeriod, nil))
+	engine.Add(v0.NewProtocolV0(v0.StorageRentVersion, logger, protocolKeeper, app.DeliverTx, invCheckPeriod, nil))
 
 	loaded, current := engine.LoadCurrentProtocol(app.GetCms().GetKVStore(mainStoreKey))
 	if !loaded {
@@ -109,7 +113,7 @@ func NewDIPApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	logger.Info(fmt.Sprintf("launch app with protocol version: %d", current))
 
 	// set txDeocder
-	app.SetTxDecoder(auth.DefaultTxDecoder(engine.GetCurrentProtocol().GetCodec()))
+	app.SetTxDecoder(vm.NewTxDecoder(engine.GetCurrentProtocol().GetCodec()))
 
 	return app
 }
@@ -122,8 +126,13 @@ func (app *DIPApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, protocol.Keys[protocol.MainStoreKey])
 }
 
+// UpgradeConfig returns the software upgrade scheduled in the committed state
+func (app *DIPApp) UpgradeConfig() (sdk.UpgradeConfig, bool) {
+	return app.Engine.GetUpgradeConfigByStore(app.GetCms().GetKVStore(protocol.Keys[protocol.MainStoreKey]))
+}
+
 // hook function for BaseApp's EndBlock(upgrade)
-func (app *DIPApp) postEndBlocker(res *abci.ResponseEndBlock) {
+func (app *DIPApp) postEndBlocker(ctx sdk.Context, res *abci.ResponseEndBlock) {
 	appVersion := app.Engine.GetCurrentVersion()
 	for _, event := range res.Events {
 		if event.Type == sdk.AppVersionEvent {
@@ -142,9 +151,9 @@ func (app *DIPApp) postEndBlocker(res *abci.ResponseEndBlock) {
 		return
 	}
 
-	success := app.Engine.Activate(appVersion)
+	success := app.Engine.Activate(appVersion, ctx)
 	if success {
-		app.SetTxDecoder(auth.DefaultTxDecoder(app.Engine.GetCurrentProtocol().GetCodec()))
+		app.SetTxDecoder(vm.NewTxDecoder(app.Engine.GetCurrentProtocol().GetCodec()))
 		return
 	}
 
@@ -156,3 +165,9 @@ func (app *DIPApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 	return app.Engine.GetCurrentProtocol().ExportAppStateAndValidators(ctx, forZeroHeight, jailWhiteList)
 }
+
+// ExportVMSnapshot streams the vm state at the last height into w
+func (app *DIPApp) ExportVMSnapshot(w io.Writer, chunkSize int) error {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	return app.Engine.GetCurrentProtocol().ExportVMSnapshot(ctx, w, chunkSize)
+}
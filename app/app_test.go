@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	v0 "github.com/Dipper-Labs/Dipper-Protocol/app/v0"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 
@@ -20,7 +21,7 @@ import (
 
 var (
 	// the genesis file in unittest/ should be modified with this
-	totalModuleNum = 14
+	totalModuleNum = 15
 )
 
 func TestExport(t *testing.T) {
@@ -91,6 +92,8 @@ func TestExport(t *testing.T) {
 	})
 
 	///////////////////// test postEndBloker /////////////////////
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
 	// situation 1
 	testInput := &abci.ResponseEndBlock{}
 	event1 := abci.Event{
@@ -107,13 +110,13 @@ func TestExport(t *testing.T) {
 	}
 	testInput.Events = append(testInput.Events, event1, event2)
 	require.NotPanics(t, func() {
-		app.postEndBlocker(testInput)
+		app.postEndBlocker(ctx, testInput)
 	})
 
 	// situation 2
 	testInput.Events = testInput.Events[:1]
 	require.NotPanics(t, func() {
-		app.postEndBlocker(testInput)
+		app.postEndBlocker(ctx, testInput)
 	})
 
 	// situation 3
@@ -126,7 +129,7 @@ func TestExport(t *testing.T) {
 		},
 	}
 	require.NotPanics(t, func() {
-		app.postEndBlocker(testInput)
+		app.postEndBlocker(ctx, testInput)
 	})
 
 	// situation 4
@@ -140,6 +143,13 @@ func TestExport(t *testing.T) {
 	}
 
 	require.NotPanics(t, func() {
-		app.postEndBlocker(testInput)
+		app.postEndBlocker(ctx, testInput)
+	})
+
+	// situation 5 : the eth compatible protocol gets activated
+	testInput.Events[0].Attributes[0].Value = []byte(strconv.FormatUint(v0.EthCompatibleVersion, 10))
+	require.NotPanics(t, func() {
+		app.postEndBlocker(ctx, testInput)
 	})
+	require.Equal(t, uint64(v0.EthCompatibleVersion), app.Engine.GetCurrentVersion())
 }
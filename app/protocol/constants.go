@@ -17,6 +17,7 @@ const (
 	UpgradeModuleName      = "upgrade"
 	GuardianModuleName     = "guardian"
 	VMModuleName           = "vm"
+	TokenModuleName        = "token"
 )
 
 // all store keys name
@@ -44,11 +44,12 @@ func (pe *ProtocolEngine) LoadCurrentProtocol(kvStore sdk.KVStore) (bool, uint64
 	return flag, current
 }
 
-func (pe *ProtocolEngine) Activate(version uint64) bool {
+// Activate switches to the protocol of version, its Init runs on ctx once its context is loaded
+func (pe *ProtocolEngine) Activate(version uint64, ctx sdk.Context) bool {
 	protocol, flag := pe.protocols[version]
 	if flag {
-		protocol.Init()
 		protocol.LoadContext()
+		protocol.Init(ctx)
 		pe.current = version
 	}
 	return flag
@@ -35,7 +35,7 @@ func TestProtocolEngine(t *testing.T) {
 	num := rand.Intn(3) + 1
 	for i := 0; i < num; i++ {
 		engine.Add(NewMockProtocol(uint64(i)))
-		require.Equal(t, true, engine.Activate(uint64(i)))
+		require.Equal(t, true, engine.Activate(uint64(i), ctx))
 		protocolKeeper.SetCurrentVersion(ctx, uint64(i))
 	}
 
@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"encoding/json"
+	"io"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -83,13 +84,18 @@ func (m *MockProtocol) ExportAppStateAndValidators(ctx sdk.Context, forZeroHeigh
 	return json.RawMessage{}, nil, nil
 }
 
+// ExportVMSnapshot - do nothing
+func (m *MockProtocol) ExportVMSnapshot(ctx sdk.Context, w io.Writer, chunkSize int) error {
+	return nil
+}
+
 // LoadContext - do nothing
 func (m *MockProtocol) LoadContext() {
 	// do nothing
 }
 
 // Init - initialize
-func (m *MockProtocol) Init() {
+func (m *MockProtocol) Init(ctx sdk.Context) {
 	// do nothing
 }
 
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
 func TestMockProtocol(t *testing.T) {
@@ -15,7 +17,7 @@ func TestMockProtocol(t *testing.T) {
 		mockProtocol.LoadContext()
 	})
 	require.NotPanics(t, func() {
-		mockProtocol.Init()
+		mockProtocol.Init(sdk.Context{})
 	})
 
 	require.NotNil(t, mockProtocol.GetRouter())
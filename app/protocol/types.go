@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"encoding/json"
+	"io"
 
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -21,9 +22,10 @@ type Protocol interface {
 	GetEndBlocker() sdk.EndBlocker
 
 	ExportAppStateAndValidators(ctx sdk.Context, forZeroHeight bool, jailWhiteList []string) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error)
+	ExportVMSnapshot(ctx sdk.Context, w io.Writer, chunkSize int) error
 
 	LoadContext()
-	Init()
+	Init(ctx sdk.Context)
 	GetCodec() *codec.Codec
 
 	//for test
@@ -0,0 +1,40 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// CircuitBreaker tells if the msgs of a route ("vm") or of a msg type of a route
+// ("bank/send") are disabled
+type CircuitBreaker interface {
+	IsRouteDisabled(ctx sdk.Context, target string) bool
+}
+
+// CircuitBreakerDecorator rejects the txs carrying a msg whose route or msg type is
+// disabled, the vm halts the disabled contracts however they are called. The msgs of a
+// passed governance proposal do not go through it.
+type CircuitBreakerDecorator struct {
+	cb CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(cb CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		cb: cb,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		targets := []string{msg.Route(), fmt.Sprintf("%s/%s", msg.Route(), msg.Type())}
+		for _, target := range targets {
+			if cbd.cb.IsRouteDisabled(ctx, target) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is disabled by the guardians", target)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -26,13 +26,13 @@ func NewFeeRefundHandler(am AccountKeeper, supplyKeeper auth.SupplyKeeper, rk Re
 			return sdk.Coin{}, nil
 		}
 
-		stdTx, ok := tx.(StdTx)
+		feeTx, ok := tx.(interface{ GetFee() sdk.Coins })
 		if !ok {
 			return sdk.Coin{}, nil
 		}
 		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
-		fee := getFee(stdTx.Fee.Amount)
+		fee := getFee(feeTx.GetFee())
 
 		// if all gas has been consumed, then there is no need to run the fee refund process
 		if txResult.GasWanted <= txResult.GasUsed {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 )
 
@@ -17,4 +18,8 @@ func init() {
 	ModuleCdc = codec.New()
 	RegisterCodec(ModuleCdc)
 	ModuleCdc.Seal()
+
+	// the messages can be executed by a governance proposal
+	govtypes.RegisterProposalMsgCodec(MsgSend{}, "dip/MsgSend")
+	govtypes.RegisterProposalMsgCodec(MsgMultiSend{}, "dip/MsgMultiSend")
 }
@@ -2,6 +2,7 @@ package v0
 
 import (
 	"encoding/json"
+	"io"
 
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -26,6 +27,11 @@ func (p *ProtocolV0) ExportAppStateAndValidators(ctx sdk.Context, forZeroHeight
 	return appState, validators, nil
 }
 
+// ExportVMSnapshot streams the vm state into w as a snapshot
+func (p *ProtocolV0) ExportVMSnapshot(ctx sdk.Context, w io.Writer, chunkSize int) error {
+	return p.vmKeeper.StateDB.WithContext(ctx).ExportSnapshot(w, chunkSize)
+}
+
 func (p *ProtocolV0) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) {
 	applyWhiteList := false
 
@@ -1,14 +1,18 @@
 package gov_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/bank"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -355,3 +359,168 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestExecuteMsgsProposalEndBlocker(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+	supplyKeeper := getProtocolV0(t, input.mApp).SupplyKeeper()
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	initGenAccount(t, ctx, input.mApp)
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	require.Equal(t, input.keeper.GetGovernanceAccount(ctx).GetAddress(), gov.ModuleAddress)
+
+	recipient := sdk.AccAddress(pubkeys[1].Address())
+
+	tokens := func(power int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(power)))
+	}
+
+	// the module account holds the deposits, the test funds it directly
+	err := supplyKeeper.SendCoinsFromAccountToModule(ctx, input.addrs[0], gov.ModuleName, tokens(10))
+	require.NoError(t, err)
+
+	voteProposal := func(msgs ...sdk.Msg) uint64 {
+		proposal, err := input.keeper.SubmitProposal(ctx, gov.NewExecuteMsgsProposal("Test", "description", msgs), input.addrs[0])
+		require.NoError(t, err)
+		input.keeper.ActivateVotingPeriod(ctx, proposal)
+
+		err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+		require.NoError(t, err)
+		return proposal.ProposalID
+	}
+
+	endVotingPeriod := func() {
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+		EndBlocker(ctx, input.keeper)
+	}
+
+	proposalStatus := func(proposalID uint64) ProposalStatus {
+		proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		return proposal.Status
+	}
+
+	// the module funds are moved by the passed proposal
+	proposalID := voteProposal(bank.NewMsgSend(gov.ModuleAddress, recipient, tokens(5)))
+	endVotingPeriod()
+	require.Equal(t, gov.StatusPassed, proposalStatus(proposalID))
+	require.True(t, input.ak.GetAccount(ctx, recipient).GetCoins().IsEqual(tokens(5)))
+
+	// both proposals can be paid when they are submitted, the first one to be executed leaves
+	// too little for the second send of the other one and its first send is dropped too
+	firstID := voteProposal(bank.NewMsgSend(gov.ModuleAddress, recipient, tokens(3)))
+	secondID := voteProposal(
+		bank.NewMsgSend(gov.ModuleAddress, recipient, tokens(1)),
+		bank.NewMsgSend(gov.ModuleAddress, recipient, tokens(3)),
+	)
+	endVotingPeriod()
+	require.Equal(t, gov.StatusPassed, proposalStatus(firstID))
+	require.Equal(t, gov.StatusFailed, proposalStatus(secondID))
+	require.True(t, input.ak.GetAccount(ctx, recipient).GetCoins().IsEqual(tokens(8)))
+	require.True(t, input.keeper.GetGovernanceAccount(ctx).GetCoins().IsEqual(tokens(2)))
+
+	// the messages are not delivered on submission, a proposal the module can not pay is
+	// submitted and fails once it passes
+	unpaidID := voteProposal(bank.NewMsgSend(gov.ModuleAddress, recipient, tokens(3)))
+	require.True(t, input.ak.GetAccount(ctx, recipient).GetCoins().IsEqual(tokens(8)))
+	endVotingPeriod()
+	require.Equal(t, gov.StatusFailed, proposalStatus(unpaidID))
+	require.True(t, input.keeper.GetGovernanceAccount(ctx).GetCoins().IsEqual(tokens(2)))
+}
+
+func TestExecuteMsgsProposalContract(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+	vmKeeper := getProtocolV0(t, input.mApp).VMKeeper()
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	initGenAccount(t, ctx, input.mApp)
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	voteProposal := func(msgs ...sdk.Msg) uint64 {
+		proposal, err := input.keeper.SubmitProposal(ctx, gov.NewExecuteMsgsProposal("Test", "description", msgs), input.addrs[0])
+		require.NoError(t, err)
+		input.keeper.ActivateVotingPeriod(ctx, proposal)
+
+		err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+		require.NoError(t, err)
+		return proposal.ProposalID
+	}
+
+	endVotingPeriod := func() {
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+		EndBlocker(ctx, input.keeper)
+	}
+
+	receipt := func(proposalID uint64, index int) *vm.Receipt {
+		txHash := tmhash.Sum([]byte(fmt.Sprintf("%s/proposal/%d/msg/%d", gov.ModuleName, proposalID, index)))
+		receipt := vmKeeper.GetReceipt(ctx, sdk.BytesToHash(txHash))
+		require.NotNil(t, receipt)
+		require.Equal(t, vm.ReceiptStatusSuccessful, receipt.Status)
+		return receipt
+	}
+
+	// the runtime code returns the gas price
+	code := sdk.FromHex("6009600c60003960096000f33a60005260206000f3")
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+
+	// both creations of a proposal get a receipt and a contract of their own
+	createID := voteProposal(
+		vm.NewMsgContract(gov.ModuleAddress, nil, code, zero),
+		vm.NewMsgContract(gov.ModuleAddress, nil, code, zero),
+	)
+	endVotingPeriod()
+	proposal, ok := input.keeper.GetProposal(ctx, createID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+
+	first, second := receipt(createID, 0).ContractAddress, receipt(createID, 1).ContractAddress
+	require.False(t, first.Equals(second))
+	require.NotEmpty(t, vmKeeper.GetCode(ctx, first))
+	require.NotEmpty(t, vmKeeper.GetCode(ctx, second))
+
+	// the created contract is called by the next proposal
+	callID := voteProposal(vm.NewMsgContract(gov.ModuleAddress, first, []byte{0}, zero))
+	endVotingPeriod()
+	proposal, ok = input.keeper.GetProposal(ctx, callID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+	require.True(t, receipt(callID, 0).GasUsed > 0)
+	require.Equal(t, uint64(3), input.keeper.GetGovernanceSequence(ctx))
+	require.Equal(t, uint64(0), input.keeper.GetGovernanceAccount(ctx).GetSequence())
+
+	// the messages run out of the gas of the proposal, the runtime code loops forever
+	loopID := voteProposal(vm.NewMsgContract(gov.ModuleAddress, nil, sdk.FromHex("6004600c60003960046000f35b600056"), zero))
+	endVotingPeriod()
+	proposal, ok = input.keeper.GetProposal(ctx, loopID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+
+	loop := receipt(loopID, 0).ContractAddress
+	callLoopID := voteProposal(
+		vm.NewMsgContract(gov.ModuleAddress, first, []byte{0}, zero),
+		vm.NewMsgContract(gov.ModuleAddress, loop, []byte{0}, zero),
+	)
+	endVotingPeriod()
+	proposal, ok = input.keeper.GetProposal(ctx, callLoopID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusFailed, proposal.Status)
+}
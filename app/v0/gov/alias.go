@@ -17,7 +17,10 @@ const (
 	DefaultParamspace           = types.DefaultParamspace
 	TypeMsgDeposit              = types.TypeMsgDeposit
 	TypeMsgVote                 = types.TypeMsgVote
+	TypeMsgWeightedVote         = types.TypeMsgWeightedVote
 	TypeMsgSubmitProposal       = types.TypeMsgSubmitProposal
+	TypeMsgDelegateVote         = types.TypeMsgDelegateVote
+	TypeMsgUndelegateVote       = types.TypeMsgUndelegateVote
 	StatusNil                   = types.StatusNil
 	StatusDepositPeriod         = types.StatusDepositPeriod
 	StatusVotingPeriod          = types.StatusVotingPeriod
@@ -26,6 +29,9 @@ const (
 	StatusFailed                = types.StatusFailed
 	ProposalTypeText            = types.ProposalTypeText
 	ProposalTypeSoftwareUpgrade = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeExecuteMsgs     = types.ProposalTypeExecuteMsgs
+	MaxExecuteMsgs              = types.MaxExecuteMsgs
+	ExecuteMsgsGasLimit         = types.ExecuteMsgsGasLimit
 	QueryParams                 = types.QueryParams
 	QueryProposals              = types.QueryProposals
 	QueryProposal               = types.QueryProposal
@@ -34,6 +40,9 @@ const (
 	QueryVotes                  = types.QueryVotes
 	QueryVote                   = types.QueryVote
 	QueryTally                  = types.QueryTally
+	QueryGovDelegation          = types.QueryGovDelegation
+	QueryRepresentative         = types.QueryRepresentative
+	QueryRepresentatives        = types.QueryRepresentatives
 	ParamDeposit                = types.ParamDeposit
 	ParamVoting                 = types.ParamVoting
 	ParamTallying               = types.ParamTallying
@@ -48,6 +57,7 @@ var (
 	// functions aliases
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
+	RegisterProposalMsgCodec      = types.RegisterProposalMsgCodec
 	ValidateAbstract              = types.ValidateAbstract
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
@@ -58,6 +68,8 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidRepresentative      = types.ErrInvalidRepresentative
+	ErrUnknownGovDelegation       = types.ErrUnknownGovDelegation
 	DefaultGenesisState           = types.DefaultGenesisState
 	NewGenesisState               = types.NewGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -75,9 +87,16 @@ var (
 	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
+	GovDelegationKey              = types.GovDelegationKey
+	RepresentativeDelegatorsKey   = types.RepresentativeDelegatorsKey
+	RepresentativeDelegatorKey    = types.RepresentativeDelegatorKey
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgWeightedVote            = types.NewMsgWeightedVote
+	NewMsgDelegateVote            = types.NewMsgDelegateVote
+	NewMsgUndelegateVote          = types.NewMsgUndelegateVote
+	NewGovDelegation              = types.NewGovDelegation
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -90,6 +109,10 @@ var (
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewTextProposal               = types.NewTextProposal
+	NewExecuteMsgsProposal        = types.NewExecuteMsgsProposal
+	NewProposalMsg                = types.NewProposalMsg
+	WithProposalMsg               = types.WithProposalMsg
+	GetProposalMsg                = types.GetProposalMsg
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -97,48 +120,71 @@ var (
 	NewQueryDepositParams         = types.NewQueryDepositParams
 	NewQueryVoteParams            = types.NewQueryVoteParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
+	NewQueryGovDelegationParams   = types.NewQueryGovDelegationParams
+	NewQueryRepresentativeParams  = types.NewQueryRepresentativeParams
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                         = types.ModuleCdc
+	ModuleAddress                     = types.ModuleAddress
+	ProposalsKeyPrefix                = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix         = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix       = types.InactiveProposalQueuePrefix
+	ProposalIDKey                     = types.ProposalIDKey
+	GovernanceSequenceKey             = types.GovernanceSequenceKey
+	DepositsKeyPrefix                 = types.DepositsKeyPrefix
+	VotesKeyPrefix                    = types.VotesKeyPrefix
+	GovDelegationsKeyPrefix           = types.GovDelegationsKeyPrefix
+	RepresentativeDelegatorsKeyPrefix = types.RepresentativeDelegatorsKeyPrefix
+	ParamStoreKeyDepositParams        = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams         = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams          = types.ParamStoreKeyTallyParams
 )
 
 type (
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	GenesisState            = types.GenesisState
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TallyResult             = types.TallyResult
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Content                   = types.Content
+	Handler                   = types.Handler
+	Deposit                   = types.Deposit
+	Deposits                  = types.Deposits
+	GenesisState              = types.GenesisState
+	MsgSubmitProposal         = types.MsgSubmitProposal
+	MsgDeposit                = types.MsgDeposit
+	MsgVote                   = types.MsgVote
+	MsgWeightedVote           = types.MsgWeightedVote
+	MsgDelegateVote           = types.MsgDelegateVote
+	MsgUndelegateVote         = types.MsgUndelegateVote
+	GovDelegation             = types.GovDelegation
+	GovDelegations            = types.GovDelegations
+	RepresentativePower       = types.RepresentativePower
+	RepresentativePowers      = types.RepresentativePowers
+	DepositParams             = types.DepositParams
+	TallyParams               = types.TallyParams
+	VotingParams              = types.VotingParams
+	Params                    = types.Params
+	Proposal                  = types.Proposal
+	Proposals                 = types.Proposals
+	ProposalQueue             = types.ProposalQueue
+	ProposalStatus            = types.ProposalStatus
+	TallyResult               = types.TallyResult
+	TextProposal              = types.TextProposal
+	SoftwareUpgradeProposal   = types.SoftwareUpgradeProposal
+	ExecuteMsgsProposal       = types.ExecuteMsgsProposal
+	ProposalMsg               = types.ProposalMsg
+	QueryProposalParams       = types.QueryProposalParams
+	QueryDepositParams        = types.QueryDepositParams
+	QueryVoteParams           = types.QueryVoteParams
+	QueryProposalsParams      = types.QueryProposalsParams
+	QueryGovDelegationParams  = types.QueryGovDelegationParams
+	QueryRepresentativeParams = types.QueryRepresentativeParams
+	Vote                      = types.Vote
+	Votes                     = types.Votes
+	VoteOption                = types.VoteOption
+	WeightedVoteOption        = types.WeightedVoteOption
+	WeightedVoteOptions       = types.WeightedVoteOptions
 )
@@ -38,7 +38,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryGovDelegation(queryRoute, cdc),
+		GetCmdQueryRepresentative(queryRoute, cdc),
+		GetCmdQueryRepresentatives(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -591,3 +594,116 @@ $ %s query gov proposer 1
 }
 
 // DONTCOVER
+
+// GetCmdQueryGovDelegation implements the query gov delegation command.
+func GetCmdQueryGovDelegation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gov-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the representative a delegator delegated its governance vote to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance delegation of a delegator.
+
+Example:
+$ %s query gov gov-delegation dip1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryGovDelegationParams(delegatorAddr)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGovDelegation), bz)
+			if err != nil {
+				return err
+			}
+
+			var govDelegation types.GovDelegation
+			cdc.MustUnmarshalJSON(res, &govDelegation)
+			return cliCtx.PrintOutput(govDelegation)
+		},
+	}
+}
+
+// GetCmdQueryRepresentative implements the query representative command.
+func GetCmdQueryRepresentative(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "representative [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the delegators and the effective voting power of a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegators of a representative and the voting power its vote
+is counted with when none of them votes itself.
+
+Example:
+$ %s query gov representative dip1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			representativeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryRepresentativeParams(representativeAddr)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRepresentative), bz)
+			if err != nil {
+				return err
+			}
+
+			var power types.RepresentativePower
+			cdc.MustUnmarshalJSON(res, &power)
+			return cliCtx.PrintOutput(power)
+		},
+	}
+}
+
+// GetCmdQueryRepresentatives implements the query representatives command.
+func GetCmdQueryRepresentatives(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "representatives",
+		Args:  cobra.NoArgs,
+		Short: "Query the effective voting power of all the representatives",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegators and the effective voting power of all the representatives.
+
+Example:
+$ %s query gov representatives
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRepresentatives), nil)
+			if err != nil {
+				return err
+			}
+
+			var powers types.RepresentativePowers
+			cdc.MustUnmarshalJSON(res, &powers)
+			return cliCtx.PrintOutput(powers)
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -74,6 +75,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdDelegateVote(cdc),
+		GetCmdUndelegateVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -166,7 +170,15 @@ Where proposal.json contains:
     "version":1,
     "software":"https://github.com/Dipper-Labs/Dipper-Protocol/releases/tag/testnet-v1.1.0",
     "switch_height":100000,
-    "threshold":"90.000000000000000000"
+    "threshold":"0.9",
+    "binaries":[
+        {
+            "platform":"linux/amd64",
+            "url":"https://github.com/Dipper-Labs/Dipper-Protocol/releases/download/testnet-v1.1.0/dipd-linux-amd64",
+            "checksum":"sha256:<hex digest of the binary>"
+        }
+    ],
+    "info":{"notes":"upgrade for smart contract"}
 }
 `,
 				version.ClientName,
@@ -195,6 +207,14 @@ Where proposal.json contains:
 			proposal.Software = proposalJSON.Software
 			proposal.SwitchHeight = proposalJSON.SwitchHeight
 			proposal.Threshold = proposalJSON.Threshold
+			proposal.Binaries = proposalJSON.Binaries
+			if len(proposalJSON.Info) != 0 {
+				var info bytes.Buffer
+				if err = json.Compact(&info, proposalJSON.Info); err != nil {
+					return err
+				}
+				proposal.Info = info.String()
+			}
 
 			if err = proposal.ValidateBasic(); err != nil {
 				return err
@@ -302,3 +322,115 @@ $ %s tx gov vote 1 yes --from mykey
 }
 
 // DONTCOVER
+
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal splitting your voting power over several options",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal giving a share of your voting power
+to each option, the weights must sum up to one. You can find the proposal-id by
+running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which options and weights user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build weighted vote message and run basic validation
+			msg := types.NewMsgWeightedVote(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdDelegateVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-vote [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate your governance vote to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Let a representative vote with the weight of your bonded tokens on
+the proposals you do not vote on yourself.
+
+Example:
+$ %s tx gov delegate-vote dip1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVote(cliCtx.GetFromAddress(), representative)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdUndelegateVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "undelegate-vote",
+		Args:  cobra.NoArgs,
+		Short: "Take back your governance vote from your representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove your governance delegation, your bonded tokens then follow the
+vote of your validators again.
+
+Example:
+$ %s tx gov undelegate-vote --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgUndelegateVote(cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/json"
+
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -13,4 +15,7 @@ type SoftwareUpgradeProposalJSON struct {
 	Software     string   `json:"software"`
 	SwitchHeight uint64   `json:"switch_height"`
 	Threshold    sdk.Dec  `json:"threshold"`
+
+	Binaries []sdk.UpgradeBinary `json:"binaries,omitempty"`
+	Info     json.RawMessage     `json:"info,omitempty"`
 }
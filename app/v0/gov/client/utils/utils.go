@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+import (
+	"strings"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,17 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize the options of user specified option=weight pairs
+func NormalizeWeightedVoteOptions(options string) string {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		fields[0] = NormalizeVoteOption(fields[0])
+		pairs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(pairs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -251,6 +251,7 @@ const (
 	StatusVotingPeriod  = gov.StatusVotingPeriod
 	StatusDepositPeriod = gov.StatusDepositPeriod
 	StatusRejected      = gov.StatusRejected
+	OptionEmpty         = gov.OptionEmpty
 	OptionYes           = gov.OptionYes
 	RouterKey           = gov.RouterKey
 	OptionAbstain       = gov.OptionAbstain
@@ -272,17 +273,18 @@ const (
 )
 
 type (
-	GenesisState   = gov.GenesisState
-	Proposal       = gov.Proposal
-	Content        = gov.Content
-	DepositParams  = gov.DepositParams
-	VotingParams   = gov.VotingParams
-	TallyParams    = gov.TallyParams
-	Proposals      = gov.Proposals
-	Deposit        = gov.Deposit
-	Vote           = gov.Vote
-	TallyResult    = gov.TallyResult
-	ProposalStatus = gov.ProposalStatus
+	GenesisState        = gov.GenesisState
+	Proposal            = gov.Proposal
+	Content             = gov.Content
+	DepositParams       = gov.DepositParams
+	VotingParams        = gov.VotingParams
+	TallyParams         = gov.TallyParams
+	Proposals           = gov.Proposals
+	Deposit             = gov.Deposit
+	Vote                = gov.Vote
+	TallyResult         = gov.TallyResult
+	ProposalStatus      = gov.ProposalStatus
+	WeightedVoteOptions = gov.WeightedVoteOptions
 )
 
 var (
@@ -302,4 +304,6 @@ var (
 	NewQuerier                 = gov.NewQuerier
 	NewMsgVote                 = gov.NewMsgVote
 	EmptyTallyResult           = gov.EmptyTallyResult
+	NewGovDelegation           = gov.NewGovDelegation
+	NewWeightedVoteOption      = gov.NewWeightedVoteOption
 )
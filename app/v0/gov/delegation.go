@@ -0,0 +1,158 @@
+package gov
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking/exported"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// GetGovDelegation gets the gov delegation of a delegator
+func (keeper Keeper) GetGovDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (govDelegation GovDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovDelegationKey(delegatorAddr))
+	if bz == nil {
+		return govDelegation, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &govDelegation)
+	return govDelegation, true
+}
+
+// SetGovDelegation sets the gov delegation of a delegator, replacing its previous representative
+func (keeper Keeper) SetGovDelegation(ctx sdk.Context, govDelegation GovDelegation) {
+	keeper.DeleteGovDelegation(ctx, govDelegation.Delegator)
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(govDelegation)
+	store.Set(types.GovDelegationKey(govDelegation.Delegator), bz)
+	store.Set(types.RepresentativeDelegatorKey(govDelegation.Representative, govDelegation.Delegator), []byte{})
+}
+
+// DeleteGovDelegation deletes the gov delegation of a delegator
+func (keeper Keeper) DeleteGovDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	govDelegation, found := keeper.GetGovDelegation(ctx, delegatorAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GovDelegationKey(delegatorAddr))
+	store.Delete(types.RepresentativeDelegatorKey(govDelegation.Representative, delegatorAddr))
+}
+
+// IterateGovDelegations iterates over all the gov delegations and performs a callback function
+func (keeper Keeper) IterateGovDelegations(ctx sdk.Context, cb func(govDelegation GovDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var govDelegation GovDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &govDelegation)
+
+		if cb(govDelegation) {
+			break
+		}
+	}
+}
+
+// GetAllGovDelegations returns all the gov delegations from the store
+func (keeper Keeper) GetAllGovDelegations(ctx sdk.Context) (govDelegations GovDelegations) {
+	keeper.IterateGovDelegations(ctx, func(govDelegation GovDelegation) bool {
+		govDelegations = append(govDelegations, govDelegation)
+		return false
+	})
+	return
+}
+
+// GetRepresentativeDelegators returns the delegators of a representative
+func (keeper Keeper) GetRepresentativeDelegators(ctx sdk.Context, representativeAddr sdk.AccAddress) (delegators []sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.RepresentativeDelegatorsKey(representativeAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()[len(prefix):]))
+	}
+	return
+}
+
+// GetRepresentativePower returns the governance weight the vote of a representative is
+// counted with when none of its delegators votes itself
+func (keeper Keeper) GetRepresentativePower(ctx sdk.Context, representativeAddr sdk.AccAddress) RepresentativePower {
+	return keeper.representativePower(ctx, keeper.bondedValidatorGovInfos(ctx), representativeAddr)
+}
+
+// GetAllRepresentativePowers returns the governance weight of all the representatives
+func (keeper Keeper) GetAllRepresentativePowers(ctx sdk.Context) (powers RepresentativePowers) {
+	validators := keeper.bondedValidatorGovInfos(ctx)
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RepresentativeDelegatorsKeyPrefix)
+	defer iterator.Close()
+
+	var last sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		representativeAddr := sdk.AccAddress(iterator.Key()[1 : 1+sdk.AddrLen])
+		if representativeAddr.Equals(last) {
+			continue
+		}
+		last = representativeAddr
+
+		powers = append(powers, keeper.representativePower(ctx, validators, representativeAddr))
+	}
+	return
+}
+
+func (keeper Keeper) representativePower(ctx sdk.Context, validators map[string]validatorGovInfo, representativeAddr sdk.AccAddress) RepresentativePower {
+	delegators := keeper.GetRepresentativeDelegators(ctx, representativeAddr)
+
+	// the validators vote with the weight of their delegators, the delegators of a
+	// representative turned validator are not counted with its vote
+	power := sdk.ZeroDec()
+	if _, ok := validators[sdk.ValAddress(representativeAddr).String()]; !ok {
+		power = keeper.delegatorPower(ctx, validators, representativeAddr)
+		for _, delegator := range delegators {
+			if _, ok := validators[sdk.ValAddress(delegator).String()]; !ok {
+				power = power.Add(keeper.delegatorPower(ctx, validators, delegator))
+			}
+		}
+	}
+
+	return types.RepresentativePower{
+		Representative: representativeAddr,
+		Delegators:     delegators,
+		Power:          power,
+	}
+}
+
+// bondedValidatorGovInfos returns the bonded validators by operator address, with no vote
+func (keeper Keeper) bondedValidatorGovInfos(ctx sdk.Context) map[string]validatorGovInfo {
+	validators := make(map[string]validatorGovInfo)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = newValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			nil,
+		)
+
+		return false
+	})
+	return validators
+}
+
+// delegatorPower returns the voting power of the tokens a delegator bonded to the validators
+func (keeper Keeper) delegatorPower(ctx sdk.Context, validators map[string]validatorGovInfo, delegatorAddr sdk.AccAddress) sdk.Dec {
+	power := sdk.ZeroDec()
+	keeper.sk.IterateDelegations(ctx, delegatorAddr, func(index int64, delegation exported.DelegationI) (stop bool) {
+		if val, ok := validators[delegation.GetValidatorAddr().String()]; ok {
+			delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+			power = power.Add(delegatorShare.MulInt(val.BondedTokens))
+		}
+		return false
+	})
+	return power
+}
@@ -33,6 +33,8 @@ type StakingKeeper interface {
 
 	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
 
+	Validator(sdk.Context, sdk.ValAddress) stakingexported.ValidatorI // get a particular validator by operator address
+
 	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
 }
@@ -11,6 +11,7 @@ import (
 func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data GenesisState) {
 
 	k.setProposalID(ctx, data.StartingProposalID)
+	k.setGovernanceSequence(ctx, data.GovernanceSequence)
 	k.setDepositParams(ctx, data.DepositParams)
 	k.setVotingParams(ctx, data.VotingParams)
 	k.setTallyParams(ctx, data.TallyParams)
@@ -31,6 +32,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 		k.SetVote(ctx, vote.ProposalID, vote.Voter, vote)
 	}
 
+	for _, govDelegation := range data.GovDelegations {
+		k.SetGovDelegation(ctx, govDelegation)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case StatusDepositPeriod:
@@ -71,8 +76,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	return GenesisState{
 		StartingProposalID: startingProposalID,
+		GovernanceSequence: k.GetGovernanceSequence(ctx),
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
+		GovDelegations:     k.GetAllGovDelegations(ctx),
 		Proposals:          proposals,
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
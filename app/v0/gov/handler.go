@@ -22,6 +22,15 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgWeightedVote:
+			return handleMsgWeightedVote(ctx, keeper, msg)
+
+		case MsgDelegateVote:
+			return handleMsgDelegateVote(ctx, keeper, msg)
+
+		case MsgUndelegateVote:
+			return handleMsgUndelegateVote(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -105,3 +114,71 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) (*sdk.Result, er
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 
 }
+
+func handleMsgWeightedVote(ctx sdk.Context, keeper Keeper, msg MsgWeightedVote) (*sdk.Result, error) {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgDelegateVote(ctx sdk.Context, keeper Keeper, msg MsgDelegateVote) (*sdk.Result, error) {
+	// validators vote with the weight of their delegators and can neither delegate their vote nor represent others
+	if keeper.sk.Validator(ctx, sdk.ValAddress(msg.Delegator)) != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidRepresentative, "delegator %s is a validator", msg.Delegator)
+	}
+	if keeper.sk.Validator(ctx, sdk.ValAddress(msg.Representative)) != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidRepresentative, "representative %s is a validator", msg.Representative)
+	}
+
+	keeper.SetGovDelegation(ctx, NewGovDelegation(msg.Delegator, msg.Representative))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, msg.Representative.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUndelegateVote(ctx sdk.Context, keeper Keeper, msg MsgUndelegateVote) (*sdk.Result, error) {
+	govDelegation, found := keeper.GetGovDelegation(ctx, msg.Delegator)
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrUnknownGovDelegation, "%s", msg.Delegator)
+	}
+
+	keeper.DeleteGovDelegation(ctx, msg.Delegator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, govDelegation.Representative.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
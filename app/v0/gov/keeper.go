@@ -33,6 +33,9 @@ type Keeper struct {
 	// Proposal router
 	router Router
 
+	// Router of the protocol that delivers the messages of a passed ExecuteMsgsProposal
+	msgRouter sdk.Router
+
 	gk guardian.Keeper
 	pk sdk.ProtocolKeeper
 }
@@ -79,6 +82,12 @@ func (keeper *Keeper) SetRouter(rtr Router) {
 	rtr.Seal()
 }
 
+// SetMsgRouter sets the router the messages of an ExecuteMsgsProposal are delivered
+// through, it must be set before the keeper is given to NewGovProposalHandler
+func (keeper *Keeper) SetMsgRouter(rtr sdk.Router) {
+	keeper.msgRouter = rtr
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
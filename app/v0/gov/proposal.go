@@ -25,13 +25,22 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content, proposer s
 		return Proposal{}, err
 	}
 
-	// Execute the proposal content in a cache-wrapped context to validate the
-	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content, proposalID, proposer); err != nil {
-		return types.Proposal{}, err
+	if c, ok := content.(ExecuteMsgsProposal); ok {
+		// the messages are only delivered once the proposal passes, the submitter does not
+		// pay for their execution
+		if err := keeper.validateExecuteMsgs(ctx, c); err != nil {
+			return types.Proposal{}, err
+		}
+	} else {
+		// Execute the proposal content in a cache-wrapped context to validate the
+		// actual parameter changes before the proposal proceeds through the
+		// governance process. State and events are not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content, proposalID, proposer); err != nil {
+			return types.Proposal{}, err
+		}
 	}
 
 	submitTime := ctx.BlockHeader().Time
@@ -161,6 +170,25 @@ func (keeper Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(ProposalIDKey, bz)
 }
 
+// GetGovernanceSequence gets the sequence the next message of an ExecuteMsgsProposal is
+// signed with by the governance account
+func (keeper Keeper) GetGovernanceSequence(ctx sdk.Context) (sequence uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GovernanceSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sequence)
+	return sequence
+}
+
+// setGovernanceSequence sets the governance sequence to the store
+func (keeper Keeper) setGovernanceSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(sequence)
+	store.Set(GovernanceSequenceKey, bz)
+}
+
 // ActivateVotingPeriod - active voting period
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal Proposal) { //TODO rename to activateVotingPeriod
 	proposal.VotingStartTime = ctx.BlockHeader().Time
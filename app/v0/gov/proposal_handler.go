@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
@@ -20,6 +22,13 @@ func NewGovProposalHandler(k Keeper) Handler {
 			}
 			return handleSoftwareUpgradeProposal(ctx, k, c, pid, proposer)
 
+		case ProposalTypeExecuteMsgs == content.ProposalType():
+			c, ok := content.(ExecuteMsgsProposal)
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "proposal type must be ExecuteMsgsProposal")
+			}
+			return handleExecuteMsgsProposal(ctx, k, c, pid)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov proposal type: %s", content.ProposalType())
 		}
@@ -49,8 +58,93 @@ func handleSoftwareUpgradeProposal(ctx sdk.Context, keeper Keeper, proposalConte
 	}
 
 	pd := sdk.NewProtocolDefinition(proposalContent.Version, proposalContent.Software, proposalContent.SwitchHeight, proposalContent.Threshold)
+	pd.Binaries = proposalContent.Binaries
+	pd.Info = proposalContent.Info
 	uc := sdk.NewUpgradeConfig(pid, pd)
 	keeper.pk.SetUpgradeConfig(ctx, uc)
 
 	return nil
 }
+
+// validateExecuteMsgs checks the messages of the proposal and that a route delivers each of
+// them, without delivering them
+func (keeper Keeper) validateExecuteMsgs(ctx sdk.Context, proposalContent ExecuteMsgsProposal) error {
+	if err := proposalContent.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if keeper.msgRouter == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no router to deliver the proposal messages")
+	}
+
+	for i, msg := range proposalContent.Msgs {
+		if keeper.msgRouter.Route(ctx, msg.Route()) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+	}
+
+	return nil
+}
+
+// handleExecuteMsgsProposal delivers the messages of the proposal in order, the caller runs it
+// on a cache context that is dropped when a message fails. The messages share a gas meter of
+// ExecuteMsgsGasLimit, each of them is delivered as the only message of a tx: with bytes of
+// its own the tx hash is taken from and the governance sequence increased
+func handleExecuteMsgsProposal(ctx sdk.Context, keeper Keeper, proposalContent ExecuteMsgsProposal, pid uint64) error {
+	if err := keeper.validateExecuteMsgs(ctx, proposalContent); err != nil {
+		return err
+	}
+
+	gasMeter := sdk.NewGasMeter(types.ExecuteMsgsGasLimit)
+
+	// the events are emitted once every message is delivered
+	events := sdk.EmptyEvents()
+	for i, msg := range proposalContent.Msgs {
+		sequence := keeper.incrementGovernanceSequence(ctx)
+		msgCtx := types.WithProposalMsg(ctx, types.NewProposalMsg(pid, sequence)).
+			WithGasMeter(gasMeter).
+			WithTxBytes([]byte(fmt.Sprintf("%s/proposal/%d/msg/%d", types.ModuleName, pid, i)))
+		res, err := deliverProposalMsg(msgCtx, keeper.msgRouter.Route(ctx, msg.Route()), msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		events = events.AppendEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyMsgIndex, fmt.Sprintf("%d", i)),
+			),
+		)
+		events = events.AppendEvents(res.Events)
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// deliverProposalMsg delivers msg, running out of the gas of the proposal fails it rather
+// than the block
+func deliverProposalMsg(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gas used: %d", oog.Descriptor, ctx.GasMeter().GasConsumed())
+		}
+	}()
+
+	return handler(ctx, msg)
+}
+
+// incrementGovernanceSequence increases the governance sequence as the ante handler does the
+// sequences of the signers of a tx, and returns the sequence the message is signed with. The
+// sequence is kept in the gov store since module accounts have none
+func (keeper Keeper) incrementGovernanceSequence(ctx sdk.Context) uint64 {
+	sequence := keeper.GetGovernanceSequence(ctx)
+	keeper.setGovernanceSequence(ctx, sequence+1)
+	return sequence
+}
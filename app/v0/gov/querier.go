@@ -28,6 +28,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryGovDelegation:
+			return queryGovDelegation(ctx, path[1:], req, keeper)
+		case QueryRepresentative:
+			return queryRepresentative(ctx, path[1:], req, keeper)
+		case QueryRepresentatives:
+			return queryRepresentatives(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -194,3 +200,51 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+// nolint: unparam
+func queryGovDelegation(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params QueryGovDelegationParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	govDelegation, found := keeper.GetGovDelegation(ctx, params.Delegator)
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrUnknownGovDelegation, "%s", params.Delegator)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, govDelegation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryRepresentative(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params QueryRepresentativeParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	power := keeper.GetRepresentativePower(ctx, params.Representative)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, power)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryRepresentatives(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	powers := keeper.GetAllRepresentativePowers(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, powers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
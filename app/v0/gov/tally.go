@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -36,55 +36,70 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 	results[OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]validatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = newValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			OptionEmpty,
-		)
 
-		return false
-	})
+	// fetch all the bonded validators
+	currValidators := keeper.bondedValidatorGovInfos(ctx)
+
+	// iterate over all delegations from voter, deduct from any delegated-to validators
+	tallyDelegatorVote := func(voter sdk.AccAddress, options WeightedVoteOptions) {
+		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
+				for _, option := range options {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+	}
+
+	voters := make(map[string]bool)
+	representativeVotes := make(map[string]WeightedVoteOptions)
 	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		voters[vote.Voter.String()] = true
+
 		// if validator, just record it in the map
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			currValidators[valAddrStr] = val
 		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr().String()
-
-				if val, ok := currValidators[valAddrStr]; ok {
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					currValidators[valAddrStr] = val
-
-					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-					votingPower := delegatorShare.MulInt(val.BondedTokens)
+			tallyDelegatorVote(vote.Voter, vote.WeightedOptions())
+			representativeVotes[vote.Voter.String()] = vote.WeightedOptions()
+		}
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
-					totalVotingPower = totalVotingPower.Add(votingPower)
-				}
+		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+		return false
+	})
 
-				return false
-			})
+	// the delegators who did not vote inherit the vote of their representative, the ones whose
+	// representative did not vote either inherit the vote of their validators
+	keeper.IterateGovDelegations(ctx, func(govDelegation GovDelegation) bool {
+		options, ok := representativeVotes[govDelegation.Representative.String()]
+		if !ok || voters[govDelegation.Delegator.String()] {
+			return false
+		}
+		if _, ok := currValidators[sdk.ValAddress(govDelegation.Delegator).String()]; ok {
+			return false
 		}
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+		tallyDelegatorVote(govDelegation.Delegator, options)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -92,7 +107,9 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
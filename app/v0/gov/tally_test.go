@@ -643,3 +643,181 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyRepresentativeInherit(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	initGenAccount(t, ctx, input.mApp)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	repTokens := sdk.TokensFromConsensusPower(10)
+	representativeMsg := staking.NewMsgDelegate(input.addrs[4], sdk.ValAddress(input.addrs[0]), sdk.NewCoin(sdk.DefaultBondDenom, repTokens))
+	stakingHandler(ctx, representativeMsg)
+
+	input.keeper.SetGovDelegation(ctx, NewGovDelegation(input.addrs[3], input.addrs[4]))
+
+	power := input.keeper.GetRepresentativePower(ctx, input.addrs[4])
+	require.Equal(t, []sdk.AccAddress{input.addrs[3]}, power.Delegators)
+	require.True(t, power.Power.TruncateInt().Equal(delTokens.Add(repTokens)))
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[4], OptionNo)
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := Tally(ctx, input.keeper, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.No.Equal(delTokens.Add(repTokens)))
+}
+
+func TestTallyRepresentativeOverride(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	initGenAccount(t, ctx, input.mApp)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	input.keeper.SetGovDelegation(ctx, NewGovDelegation(input.addrs[3], input.addrs[4]))
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[3], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[4], OptionNo)
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := Tally(ctx, input.keeper, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.Yes.Equal(sdk.TokensFromConsensusPower(35)))
+	require.True(t, tallyResults.No.Equal(sdk.TokensFromConsensusPower(6)))
+}
+
+func TestTallyWeightedVotes(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	initGenAccount(t, ctx, input.mApp)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0])
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	require.Nil(t, err)
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[3], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+	})
+	require.Nil(t, err)
+
+	vote, found := input.keeper.GetVote(ctx, proposalID, input.addrs[3])
+	require.True(t, found)
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.Len(t, vote.Options, 2)
+
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[4], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+	})
+	require.Error(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := Tally(ctx, input.keeper, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, sdk.TokensFromConsensusPower(16).String(), tallyResults.Yes.String())
+	// the shares left to validator 2 after the deduction of its delegator round down
+	require.True(t, sdk.TokensFromConsensusPower(12).Sub(tallyResults.No).LTE(sdk.OneInt()))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
 // ModuleCdc - generic sealed codec to be used throughout this module
@@ -15,9 +16,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "dip/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "dip/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "dip/MsgVote", nil)
+	cdc.RegisterConcrete(MsgWeightedVote{}, "dip/MsgWeightedVote", nil)
+	cdc.RegisterConcrete(MsgDelegateVote{}, "dip/MsgDelegateVote", nil)
+	cdc.RegisterConcrete(MsgUndelegateVote{}, "dip/MsgUndelegateVote", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "dip/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "dip/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecuteMsgsProposal{}, "dip/ExecuteMsgsProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -27,7 +32,15 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
 }
 
+// RegisterProposalMsgCodec registers a message type defined in another module for
+// the internal ModuleCdc. This allows the messages of an ExecuteMsgsProposal to be
+// correctly Amino encoded and decoded.
+func RegisterProposalMsgCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
 // TODO determine a good place to seal this codec
 func init() {
+	sdk.RegisterCodec(ModuleCdc)
 	RegisterCodec(ModuleCdc)
 }
@@ -0,0 +1,39 @@
+package types
+
+import sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+
+type contextKey int // local to the gov module
+
+const (
+	contextKeyProposalMsg contextKey = iota
+)
+
+// ProposalMsg - the proposal a message is executed for and the governance sequence the
+// message is signed with
+type ProposalMsg struct {
+	ProposalID uint64
+	Sequence   uint64
+}
+
+// NewProposalMsg creates a new ProposalMsg instance
+func NewProposalMsg(proposalID, sequence uint64) ProposalMsg {
+	return ProposalMsg{
+		ProposalID: proposalID,
+		Sequence:   sequence,
+	}
+}
+
+// WithProposalMsg - initialize Context with the proposal whose message is executed
+func WithProposalMsg(ctx sdk.Context, proposalMsg ProposalMsg) sdk.Context {
+	return ctx.WithValue(contextKeyProposalMsg, proposalMsg)
+}
+
+// GetProposalMsg - get the proposal whose message is executed from Context, false when
+// the message is one of a tx
+func GetProposalMsg(ctx sdk.Context) (ProposalMsg, bool) {
+	v := ctx.Value(contextKeyProposalMsg)
+	if v == nil {
+		return ProposalMsg{}, false
+	}
+	return v.(ProposalMsg), true
+}
@@ -20,4 +20,9 @@ var (
 	ErrSoftwareUpgradeInvalidProfiler       = sdkerrors.New(ModuleName, 12, "invalid software upgrade profiler")
 	ErrSoftwareUpgradeSwitchPeriodInProcess = sdkerrors.New(ModuleName, 13, "software upgrade already in switch period")
 	ErrSoftwareUpgradeInvalidThreshold      = sdkerrors.New(ModuleName, 14, "software upgrade Threshold should be in range [0.8, 1.0]")
+	ErrInvalidProposalMsg                   = sdkerrors.New(ModuleName, 15, "invalid proposal message")
+	ErrInvalidRepresentative                = sdkerrors.New(ModuleName, 16, "invalid representative")
+	ErrUnknownGovDelegation                 = sdkerrors.New(ModuleName, 17, "unknown gov delegation")
+	ErrSoftwareUpgradeInvalidBinary         = sdkerrors.New(ModuleName, 18, "invalid software upgrade binary")
+	ErrSoftwareUpgradeInvalidInfo           = sdkerrors.New(ModuleName, 19, "invalid software upgrade info")
 )
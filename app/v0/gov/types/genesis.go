@@ -9,13 +9,15 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64        `json:"starting_proposal_id" yaml:"starting_proposal_id"`
-	Deposits           Deposits      `json:"deposits" yaml:"deposits"`
-	Votes              Votes         `json:"votes" yaml:"votes"`
-	Proposals          []Proposal    `json:"proposals" yaml:"proposals"`
-	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
-	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+	StartingProposalID uint64         `json:"starting_proposal_id" yaml:"starting_proposal_id"`
+	GovernanceSequence uint64         `json:"governance_sequence,omitempty" yaml:"governance_sequence,omitempty"`
+	Deposits           Deposits       `json:"deposits" yaml:"deposits"`
+	Votes              Votes          `json:"votes" yaml:"votes"`
+	GovDelegations     GovDelegations `json:"gov_delegations" yaml:"gov_delegations"`
+	Proposals          []Proposal     `json:"proposals" yaml:"proposals"`
+	DepositParams      DepositParams  `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams       VotingParams   `json:"voting_params" yaml:"voting_params"`
+	TallyParams        TallyParams    `json:"tally_params" yaml:"tally_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -76,5 +78,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s", data.DepositParams.MinDeposit.String())
 	}
 
+	for _, govDelegation := range data.GovDelegations {
+		if govDelegation.Delegator.Empty() || govDelegation.Representative.Empty() || govDelegation.Delegator.Equals(govDelegation.Representative) {
+			return fmt.Errorf("invalid governance delegation %s", govDelegation.String())
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// GovDelegation credits the governance weight of the delegator, its bonded tokens, to
+// the vote of the representative unless the delegator votes itself
+type GovDelegation struct {
+	Delegator      sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Representative sdk.AccAddress `json:"representative" yaml:"representative"`
+}
+
+// NewGovDelegation creates a new GovDelegation instance
+func NewGovDelegation(delegator, representative sdk.AccAddress) GovDelegation {
+	return GovDelegation{
+		Delegator:      delegator,
+		Representative: representative,
+	}
+}
+
+func (gd GovDelegation) String() string {
+	return fmt.Sprintf("delegator %s delegated its vote to %s", gd.Delegator, gd.Representative)
+}
+
+// GovDelegations is a collection of GovDelegation objects
+type GovDelegations []GovDelegation
+
+func (gds GovDelegations) String() string {
+	if len(gds) == 0 {
+		return "[]"
+	}
+	out := "Gov Delegations:"
+	for _, gd := range gds {
+		out += fmt.Sprintf("\n  %s: %s", gd.Delegator, gd.Representative)
+	}
+	return out
+}
+
+// RepresentativePower is the governance weight a representative votes with, its own
+// bonded tokens and the ones of its delegators
+type RepresentativePower struct {
+	Representative sdk.AccAddress   `json:"representative" yaml:"representative"`
+	Delegators     []sdk.AccAddress `json:"delegators" yaml:"delegators"`
+	Power          sdk.Dec          `json:"power" yaml:"power"`
+}
+
+func (rp RepresentativePower) String() string {
+	delegators := make([]string, len(rp.Delegators))
+	for i, delegator := range rp.Delegators {
+		delegators[i] = delegator.String()
+	}
+	return fmt.Sprintf(`Representative %s:
+  Power:      %s
+  Delegators: %s`, rp.Representative, rp.Power, strings.Join(delegators, ", "))
+}
+
+// RepresentativePowers is a collection of RepresentativePower objects
+type RepresentativePowers []RepresentativePower
+
+func (rps RepresentativePowers) String() string {
+	if len(rps) == 0 {
+		return "[]"
+	}
+	out := make([]string, len(rps))
+	for i, rp := range rps {
+		out[i] = rp.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+
+	"github.com/tendermint/tendermint/crypto"
 )
 
 const (
@@ -25,6 +27,10 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// ModuleAddress is the address of the governance module account, the signer of the
+// messages of an ExecuteMsgsProposal
+var ModuleAddress = sdk.AccAddress(crypto.AddressHash([]byte(ModuleName)))
+
 // Keys for governance store
 // Items are stored with the following key: values
 //
@@ -36,14 +42,21 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04: governanceSequence
+//
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x40<delegatorAddr_Bytes>: GovDelegation
+//
+// - 0x41<representativeAddr_Bytes><delegatorAddr_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
+	GovernanceSequenceKey       = []byte{0x04}
 
 	DepositsKeyPrefix = []byte{0x10}
 
@@ -51,6 +64,9 @@ var (
 
 	SoftwareUpgradeKey   = []byte{0x30}
 	SoftwareUpgradeValue = []byte{0x00}
+
+	GovDelegationsKeyPrefix           = []byte{0x40}
+	RepresentativeDelegatorsKeyPrefix = []byte{0x41}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -112,6 +128,21 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// GovDelegationKey key of the gov delegation of a delegator from the store
+func GovDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovDelegationsKeyPrefix, delegatorAddr.Bytes()...)
+}
+
+// RepresentativeDelegatorsKey gets the first part of the delegators key based on the representative
+func RepresentativeDelegatorsKey(representativeAddr sdk.AccAddress) []byte {
+	return append(RepresentativeDelegatorsKeyPrefix, representativeAddr.Bytes()...)
+}
+
+// RepresentativeDelegatorKey key of a delegator of a representative from the store
+func RepresentativeDelegatorKey(representativeAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(RepresentativeDelegatorsKey(representativeAddr), delegatorAddr.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -11,11 +11,14 @@ import (
 const (
 	TypeMsgDeposit                 = "deposit"
 	TypeMsgVote                    = "vote"
+	TypeMsgWeightedVote            = "weighted_vote"
 	TypeMsgSubmitProposal          = "submit_proposal"
 	TypeMsgSoftwareUpgradeProposal = "software_upgrade_proposal"
+	TypeMsgDelegateVote            = "delegate_vote"
+	TypeMsgUndelegateVote          = "undelegate_vote"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgWeightedVote{}, MsgDelegateVote{}, MsgUndelegateVote{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
@@ -166,3 +169,137 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgWeightedVote splits the voting power of the voter over several options
+type MsgWeightedVote struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  options chosen by the voter with the weights summing up to one
+}
+
+func NewMsgWeightedVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgWeightedVote {
+	return MsgWeightedVote{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgWeightedVote) Route() string { return RouterKey }
+func (msg MsgWeightedVote) Type() string  { return TypeMsgWeightedVote }
+
+// Implements Msg.
+func (msg MsgWeightedVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return sdkerrors.Wrap(ErrInvalidVote, msg.Options.String())
+	}
+
+	return nil
+}
+
+func (msg MsgWeightedVote) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgDelegateVote delegates the governance weight of the delegator to a representative
+type MsgDelegateVote struct {
+	Delegator      sdk.AccAddress `json:"delegator" yaml:"delegator"`           // address of the delegator
+	Representative sdk.AccAddress `json:"representative" yaml:"representative"` // address voting for the delegator
+}
+
+func NewMsgDelegateVote(delegator, representative sdk.AccAddress) MsgDelegateVote {
+	return MsgDelegateVote{delegator, representative}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgDelegateVote) Route() string { return RouterKey }
+func (msg MsgDelegateVote) Type() string  { return TypeMsgDelegateVote }
+
+// Implements Msg.
+func (msg MsgDelegateVote) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Delegator.String())
+	}
+	if msg.Representative.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Representative.String())
+	}
+	if msg.Delegator.Equals(msg.Representative) {
+		return sdkerrors.Wrap(ErrInvalidRepresentative, "the delegator can not represent itself")
+	}
+
+	return nil
+}
+
+func (msg MsgDelegateVote) String() string {
+	return fmt.Sprintf(`Delegate Vote Message:
+  Delegator:      %s
+  Representative: %s
+`, msg.Delegator, msg.Representative)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgUndelegateVote takes back the governance weight delegated to a representative
+type MsgUndelegateVote struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"` // address of the delegator
+}
+
+func NewMsgUndelegateVote(delegator sdk.AccAddress) MsgUndelegateVote {
+	return MsgUndelegateVote{delegator}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgUndelegateVote) Route() string { return RouterKey }
+func (msg MsgUndelegateVote) Type() string  { return TypeMsgUndelegateVote }
+
+// Implements Msg.
+func (msg MsgUndelegateVote) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Delegator.String())
+	}
+
+	return nil
+}
+
+func (msg MsgUndelegateVote) String() string {
+	return fmt.Sprintf(`Undelegate Vote Message:
+  Delegator: %s
+`, msg.Delegator)
+}
+
+// Implements Msg.
+func (msg MsgUndelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
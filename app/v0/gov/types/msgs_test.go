@@ -56,6 +56,12 @@ func TestMsgSubmitProposal(t *testing.T) {
 		}
 	}
 
+	binary := sdk.UpgradeBinary{
+		Platform: "linux/amd64",
+		URL:      "https://github.com/Dipper-Labs/Dipper-Protocol/releases/download/v1.0.0/dipd",
+		Checksum: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+
 	upgradeTest := []struct {
 		Title        string
 		Description  string
@@ -63,17 +69,25 @@ func TestMsgSubmitProposal(t *testing.T) {
 		Software     string
 		SwitchHeight uint64
 		Threshold    sdk.Dec
+		Binaries     []sdk.UpgradeBinary
+		Info         string
 
 		proposerAddr   sdk.AccAddress
 		initialDeposit sdk.Coins
 
 		expectPass bool
 	}{
-		{"Test Proposal", "the purpose of this proposal is to test", 0, "", 100, sdk.NewDecWithPrec(88, 2), addrs[0], coinsZero, true},
+		{"Test Proposal", "the purpose of this proposal is to test", 0, "", 100, sdk.NewDecWithPrec(88, 2), nil, "", addrs[0], coinsZero, true},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), []sdk.UpgradeBinary{binary}, `{"notes":"test"}`, addrs[0], coinsZero, true},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), []sdk.UpgradeBinary{binary, binary}, "", addrs[0], coinsZero, false},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), []sdk.UpgradeBinary{{Platform: "linux", URL: binary.URL, Checksum: binary.Checksum}}, "", addrs[0], coinsZero, false},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), []sdk.UpgradeBinary{{Platform: binary.Platform, URL: binary.URL, Checksum: "md5:d41d8cd98f00b204e9800998ecf8427e"}}, "", addrs[0], coinsZero, false},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), []sdk.UpgradeBinary{{Platform: binary.Platform, URL: binary.URL, Checksum: "sha256:e3b0"}}, "", addrs[0], coinsZero, false},
+		{"Test Proposal", "the purpose of this proposal is to test", 1, "", 100, sdk.NewDecWithPrec(88, 2), nil, "notes", addrs[0], coinsZero, false},
 	}
 
 	for i, tc := range upgradeTest {
-		softwareUpgradeProposal := NewSoftwareUpgradeProposal(tc.Title, tc.Description, tc.Version, tc.Software, tc.SwitchHeight, tc.Threshold)
+		softwareUpgradeProposal := NewSoftwareUpgradeProposal(tc.Title, tc.Description, tc.Version, tc.Software, tc.SwitchHeight, tc.Threshold, tc.Binaries, tc.Info)
 		msg := NewMsgSubmitProposal(
 			softwareUpgradeProposal,
 			tc.initialDeposit,
@@ -146,3 +160,75 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgDelegateVote(t *testing.T) {
+	tests := []struct {
+		delegatorAddr      sdk.AccAddress
+		representativeAddr sdk.AccAddress
+		expectPass         bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+		{addrs[0], addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgDelegateVote(tc.delegatorAddr, tc.representativeAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgWeightedVote(t *testing.T) {
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, true},
+		{addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(1, 1)),
+		}, true},
+		{sdk.AccAddress{}, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1))}, false},
+		{addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgWeightedVote(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.3,Abstain=0.1")
+	require.NoError(t, err)
+	require.Equal(t, "Yes=0.600000000000000000,No=0.300000000000000000,Abstain=0.100000000000000000", options.String())
+	require.True(t, ValidWeightedVoteOptions(options))
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=one")
+	require.Error(t, err)
+}
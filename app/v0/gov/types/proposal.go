@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
 )
 
 // Proposal defines a struct used by the governance module to allow for voting
@@ -253,6 +254,7 @@ func (tr TallyResult) String() string {
 const (
 	ProposalTypeText            string = "Text"
 	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+	ProposalTypeExecuteMsgs     string = "ExecuteMsgs"
 )
 
 type TextProposal struct {
@@ -280,16 +282,22 @@ func (tp TextProposal) String() string {
 `, tp.Title, tp.Description)
 }
 
+// SoftwareUpgradeProposal switches the protocol version at the switch height, the binaries
+// carry the checksum nodes verify the replacement binary against and the info is a free-form
+// JSON object written to the upgrade-info file of the nodes
 type SoftwareUpgradeProposal struct {
-	Title        string  `json:"title" yaml:"title"`
-	Description  string  `json:"description" yaml:"description"`
-	Version      uint64  `json:"version"`
-	Software     string  `json:"software"`
-	SwitchHeight uint64  `json:"switch_height"`
-	Threshold    sdk.Dec `json:"threshold"`
+	Title        string              `json:"title" yaml:"title"`
+	Description  string              `json:"description" yaml:"description"`
+	Version      uint64              `json:"version"`
+	Software     string              `json:"software"`
+	SwitchHeight uint64              `json:"switch_height"`
+	Threshold    sdk.Dec             `json:"threshold"`
+	Binaries     []sdk.UpgradeBinary `json:"binaries,omitempty"`
+	Info         string              `json:"info,omitempty"`
 }
 
-func NewSoftwareUpgradeProposal(title, description string, version uint64, software string, switchHeight uint64, threshold sdk.Dec) Content {
+func NewSoftwareUpgradeProposal(title, description string, version uint64, software string, switchHeight uint64,
+	threshold sdk.Dec, binaries []sdk.UpgradeBinary, info string) Content {
 	return SoftwareUpgradeProposal{
 		Title:        title,
 		Description:  description,
@@ -297,6 +305,8 @@ func NewSoftwareUpgradeProposal(title, description string, version uint64, softw
 		Software:     software,
 		SwitchHeight: switchHeight,
 		Threshold:    threshold,
+		Binaries:     binaries,
+		Info:         info,
 	}
 }
 
@@ -311,19 +321,127 @@ func (sup SoftwareUpgradeProposal) ValidateBasic() error {
 	if sup.Threshold.LT(sdk.NewDecWithPrec(80, 2)) || sup.Threshold.GT(sdk.NewDecWithPrec(100, 2)) {
 		return ErrSoftwareUpgradeInvalidThreshold
 	}
+
+	platforms := make(map[string]bool)
+	for _, binary := range sup.Binaries {
+		if err := binary.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(ErrSoftwareUpgradeInvalidBinary, err.Error())
+		}
+		if platforms[binary.Platform] {
+			return sdkerrors.Wrapf(ErrSoftwareUpgradeInvalidBinary, "duplicate binary for platform %s", binary.Platform)
+		}
+		platforms[binary.Platform] = true
+	}
+
+	if len(sup.Info) != 0 {
+		var info map[string]interface{}
+		if err := json.Unmarshal([]byte(sup.Info), &info); err != nil {
+			return sdkerrors.Wrapf(ErrSoftwareUpgradeInvalidInfo, "info must be a JSON object: %s", err)
+		}
+	}
+
 	return ValidateAbstract(sup)
 }
 
 func (sup SoftwareUpgradeProposal) String() string {
-	return fmt.Sprintf(`Software Upgrade Proposal:
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Software Upgrade Proposal:
+  Title:         %s
+  Description:   %s
+  Version:       %d
+  Software:      %s
+  Switch Height: %d
+  Threshold:     %s
+`, sup.Title, sup.Description, sup.Version, sup.Software, sup.SwitchHeight, sup.Threshold))
+
+	if len(sup.Binaries) != 0 {
+		b.WriteString("  Binaries:\n")
+		for _, binary := range sup.Binaries {
+			b.WriteString(fmt.Sprintf("    %s: %s %s\n", binary.Platform, binary.URL, binary.Checksum))
+		}
+	}
+	if len(sup.Info) != 0 {
+		b.WriteString(fmt.Sprintf("  Info:          %s\n", sup.Info))
+	}
+	return b.String()
+}
+
+const (
+	// MaxExecuteMsgs is the number of messages an ExecuteMsgsProposal carries at most
+	MaxExecuteMsgs = 16
+	// ExecuteMsgsGasLimit is the gas all the messages of an ExecuteMsgsProposal are
+	// delivered with together
+	ExecuteMsgsGasLimit = sdk.Gas(10000000)
+)
+
+// ExecuteMsgsProposal carries messages signed by the governance module account, they are
+// delivered in order through the router of the protocol once the proposal passes and none
+// of their state changes is kept if one of them fails
+type ExecuteMsgsProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+func NewExecuteMsgsProposal(title, description string, msgs []sdk.Msg) Content {
+	return ExecuteMsgsProposal{
+		Title:       title,
+		Description: description,
+		Msgs:        msgs,
+	}
+}
+
+var _ Content = ExecuteMsgsProposal{}
+
+// nolint
+func (emp ExecuteMsgsProposal) GetTitle() string       { return emp.Title }
+func (emp ExecuteMsgsProposal) GetDescription() string { return emp.Description }
+func (emp ExecuteMsgsProposal) ProposalRoute() string  { return RouterKey }
+func (emp ExecuteMsgsProposal) ProposalType() string   { return ProposalTypeExecuteMsgs }
+func (emp ExecuteMsgsProposal) ValidateBasic() error {
+	if len(emp.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal has no message to execute")
+	}
+	if len(emp.Msgs) > MaxExecuteMsgs {
+		return sdkerrors.Wrapf(ErrInvalidProposalContent, "proposal has %d messages, more than %d", len(emp.Msgs), MaxExecuteMsgs)
+	}
+
+	for i, msg := range emp.Msgs {
+		// a passed proposal must not submit, deposit on or vote for another one
+		if msg.Route() == RouterKey {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d is a %s message", i, RouterKey)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(ModuleAddress) {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d must be signed by the %s module account %s only", i, ModuleName, ModuleAddress)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d: %s", i, err)
+		}
+	}
+
+	return ValidateAbstract(emp)
+}
+
+func (emp ExecuteMsgsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Execute Msgs Proposal:
   Title:       %s
   Description: %s
-`, sup.Title, sup.Description)
+  Msgs:
+`, emp.Title, emp.Description))
+	for _, msg := range emp.Msgs {
+		b.WriteString(fmt.Sprintf("    %s/%s\n", msg.Route(), msg.Type()))
+	}
+	return b.String()
 }
 
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText:            {},
 	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeExecuteMsgs:     {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +24,23 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecuteMsgsProposal_ValidateBasic(t *testing.T) {
+	other := sdk.AccAddress([]byte("other_______________"))
+	tests := []struct {
+		name    string
+		msgs    []sdk.Msg
+		wantErr bool
+	}{
+		{"signed by the module", []sdk.Msg{sdk.NewTestMsg(ModuleAddress)}, false},
+		{"no message", nil, true},
+		{"signed by another account", []sdk.Msg{sdk.NewTestMsg(ModuleAddress), sdk.NewTestMsg(other)}, true},
+		{"signed by the module and another account", []sdk.Msg{sdk.NewTestMsg(ModuleAddress, other)}, true},
+		{"gov message", []sdk.Msg{NewMsgVote(ModuleAddress, 1, OptionYes)}, true},
+		{"too many messages", make([]sdk.Msg, MaxExecuteMsgs+1), true},
+	}
+	for _, tt := range tests {
+		err := NewExecuteMsgsProposal("title", "description", tt.msgs).ValidateBasic()
+		require.Equal(t, tt.wantErr, err != nil, tt.name)
+	}
+}
@@ -15,6 +15,10 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryGovDelegation   = "gov_delegation"
+	QueryRepresentative  = "representative"
+	QueryRepresentatives = "representatives"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -81,3 +85,27 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// QueryGovDelegationParams for query 'custom/gov/gov_delegation'
+type QueryGovDelegationParams struct {
+	Delegator sdk.AccAddress
+}
+
+// NewQueryGovDelegationParams creates a new instance of QueryGovDelegationParams
+func NewQueryGovDelegationParams(delegator sdk.AccAddress) QueryGovDelegationParams {
+	return QueryGovDelegationParams{
+		Delegator: delegator,
+	}
+}
+
+// QueryRepresentativeParams for query 'custom/gov/representative'
+type QueryRepresentativeParams struct {
+	Representative sdk.AccAddress
+}
+
+// NewQueryRepresentativeParams creates a new instance of QueryRepresentativeParams
+func NewQueryRepresentativeParams(representative sdk.AccAddress) QueryRepresentativeParams {
+	return QueryRepresentativeParams{
+		Representative: representative,
+	}
+}
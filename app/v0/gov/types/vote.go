@@ -3,23 +3,41 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  split of a weighted vote over the options
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, nil}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the power of the voter over several options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{proposalID, voter, OptionEmpty, options}
+}
+
+// WeightedOptions returns the split of the vote, a single option vote carries its option with a weight of one
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) != 0 {
+		return v.Options
+	}
+	return WeightedVoteOptions{NewWeightedVoteOption(v.Option, sdk.OneDec())}
 }
 
 func (v Vote) String() string {
+	if len(v.Options) != 0 {
+		return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
@@ -32,6 +50,10 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
+		if len(vot.Options) != 0 {
+			out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
+			continue
+		}
 		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
 	}
 	return out
@@ -41,7 +63,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +72,93 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption defines the share of the voting power a weighted vote gives to an option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is the split of a weighted vote over the options
+type WeightedVoteOptions []WeightedVoteOption
+
+func (o WeightedVoteOptions) String() string {
+	out := make([]string, len(o))
+	for i, option := range o {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two splits are equal.
+func (o WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(o) != len(comp) {
+		return false
+	}
+	for i := range o {
+		if o[i].Option != comp[i].Option || !o[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// WeightedVoteOptionsFromString returns the split of a weighted vote from a string of
+// comma separated option=weight pairs, e.g. "Yes=0.6,No=0.4"
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected option=weight", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid weight: %s", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns true if every option of the split is valid and listed
+// once with a positive weight, and the weights sum up to one
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	seen := make(map[VoteOption]bool)
+	total := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || seen[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+
+		seen[option.Option] = true
+		total = total.Add(option.Weight)
+	}
+	return total.Equal(sdk.OneDec())
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
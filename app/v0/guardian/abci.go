@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// EndBlocker deletes the disabled routes whose expiry height is reached, the routes are
+// enabled again from the next block on
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, disabledRoute := range k.GetDisabledRoutes(ctx) {
+		if disabledRoute.IsActive(ctx.BlockHeight() + 1) {
+			continue
+		}
+
+		k.DeleteDisabledRoute(ctx, disabledRoute.Target)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEnableRoute,
+				sdk.NewAttribute(types.AttributeKeyTarget, disabledRoute.Target),
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueExpired),
+			),
+		)
+	}
+}
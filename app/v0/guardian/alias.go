@@ -13,6 +13,9 @@ const (
 	QuerierRoute   = types.QuerierRoute
 	QueryProfilers = types.QueryProfilers
 	StoreKey       = types.StoreKey
+
+	QueryDisabledRoutes = types.QueryDisabledRoutes
+	MaxDisableBlocks    = types.MaxDisableBlocks
 )
 
 type (
@@ -20,6 +23,10 @@ type (
 	MsgDeleteProfiler = types.MsgDeleteProfiler
 	Guardian          = types.Guardian
 	Profilers         = types.Profilers
+	MsgDisableRoute   = types.MsgDisableRoute
+	MsgEnableRoute    = types.MsgEnableRoute
+	DisabledRoute     = types.DisabledRoute
+	DisabledRoutes    = types.DisabledRoutes
 )
 
 var (
@@ -29,6 +36,13 @@ var (
 	GetProfilerKey          = types.GetProfilerKey
 	GetProfilersSubspaceKey = types.GetProfilersSubspaceKey
 
+	NewMsgDisableRoute           = types.NewMsgDisableRoute
+	NewMsgEnableRoute            = types.NewMsgEnableRoute
+	NewDisabledRoute             = types.NewDisabledRoute
+	ValidateRouteTarget          = types.ValidateRouteTarget
+	GetDisabledRouteKey          = types.GetDisabledRouteKey
+	GetDisabledRoutesSubspaceKey = types.GetDisabledRoutesSubspaceKey
+
 	ErrInvalidOperator       = types.ErrInvalidOperator
 	ErrProfilerNotExists     = types.ErrProfilerNotExists
 	ErrDeleteGenesisProfiler = types.ErrDeleteGenesisProfiler
@@ -37,4 +51,8 @@ var (
 	ErrAddressEmpty          = types.ErrAddressEmpty
 	ErrAddedByEmpty          = types.ErrAddedByEmpty
 	ErrDeletedByEmpty        = types.ErrDeletedByEmpty
+	ErrInvalidRouteTarget    = types.ErrInvalidRouteTarget
+	ErrInvalidExpiryHeight   = types.ErrInvalidExpiryHeight
+	ErrRouteNotDisabled      = types.ErrRouteNotDisabled
+	ErrRouteDisabledByGov    = types.ErrRouteDisabledByGov
 )
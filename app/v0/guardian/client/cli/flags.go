@@ -1,6 +1,8 @@
 package cli
 
 const (
-	FlagAddress     = "address"
-	FlagDescription = "description"
+	FlagAddress      = "address"
+	FlagDescription  = "description"
+	FlagTarget       = "target"
+	FlagExpiryHeight = "expiry-height"
 )
@@ -22,6 +22,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	guardianQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryProfilers(cdc),
+		GetCmdQueryDisabledRoutes(cdc),
 	)...)
 
 	return guardianQueryCmd
@@ -53,3 +54,28 @@ func GetCmdQueryProfilers(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+func GetCmdQueryDisabledRoutes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disabled-routes",
+		Short:   "Query for all disabled routes",
+		Example: "dipcli query guardian disabled-routes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDisabledRoutes), nil)
+			if err != nil {
+				return err
+			}
+
+			var disabledRoutes types.DisabledRoutes
+			err = cdc.UnmarshalJSON(res, &disabledRoutes)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(disabledRoutes)
+		},
+	}
+	return cmd
+}
@@ -27,6 +27,8 @@ func GuardianCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(client.PostCommands(
 		GetCmdCreateProfiler(cdc),
 		GetCmdDeleteProfiler(cdc),
+		GetCmdDisableRoute(cdc),
+		GetCmdEnableRoute(cdc),
 	)...)
 
 	return txCmd
@@ -112,3 +114,60 @@ func GetCmdDeleteProfiler(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdDisableRoute(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-route",
+		Short: "Disable a route, a msg type of a route or a contract until the expiry height",
+		Example: "dipcli guardian disable-route --from=<key-name> --target=vm --expiry-height=<height>\n" +
+			"dipcli guardian disable-route --from=<key-name> --target=bank/send --expiry-height=<height>\n" +
+			"dipcli guardian disable-route --from=<key-name> --target=<contract address> --expiry-height=<height>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgDisableRoute(viper.GetString(FlagTarget), viper.GetInt64(FlagExpiryHeight), fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", "route, route/msg-type or bech32 encoded contract address")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "height the target is enabled again at")
+
+	cmd.MarkFlagRequired(FlagTarget)
+	cmd.MarkFlagRequired(FlagExpiryHeight)
+
+	return cmd
+}
+
+func GetCmdEnableRoute(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "enable-route",
+		Short:   "Enable a disabled route before its expiry height",
+		Example: "dipcli guardian enable-route --from=<key-name> --target=vm",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgEnableRoute(viper.GetString(FlagTarget), fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", "route, route/msg-type or bech32 encoded contract address")
+	cmd.MarkFlagRequired(FlagTarget)
+
+	return cmd
+}
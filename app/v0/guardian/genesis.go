@@ -6,7 +6,8 @@ import (
 )
 
 type GenesisState struct {
-	Profilers []types.Guardian `json:"profilers"`
+	Profilers      []types.Guardian      `json:"profilers"`
+	DisabledRoutes []types.DisabledRoute `json:"disabled_routes,omitempty"`
 }
 
 func NewGenesisState(profilers []types.Guardian) GenesisState {
@@ -19,6 +20,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, profiler := range data.Profilers {
 		keeper.AddProfiler(ctx, profiler)
 	}
+	for _, disabledRoute := range data.DisabledRoutes {
+		keeper.SetDisabledRoute(ctx, disabledRoute)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
@@ -31,7 +35,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		profilers = append(profilers, profiler)
 	}
 
-	return NewGenesisState(profilers)
+	gs := NewGenesisState(profilers)
+	gs.DisabledRoutes = k.GetDisabledRoutes(ctx)
+	return gs
 }
 
 func DefaultGenesisState() GenesisState {
@@ -1,17 +1,27 @@
 package guardian
 
 import (
+	"fmt"
+
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
 )
 
 func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 		switch msg := msg.(type) {
 		case MsgAddProfiler:
 			return handleMsgAddProfiler(ctx, k, msg)
 		case MsgDeleteProfiler:
 			return handleMsgDeleteProfiler(ctx, k, msg)
+		case MsgDisableRoute:
+			return handleMsgDisableRoute(ctx, k, msg)
+		case MsgEnableRoute:
+			return handleMsgEnableRoute(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -19,7 +29,7 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgAddProfiler(ctx sdk.Context, k Keeper, msg MsgAddProfiler) (*sdk.Result, error) {
-	if profiler, found := k.GetProfiler(ctx, msg.AddedBy); !found || profiler.AccountType != Genesis {
+	if !isOperator(ctx, k, msg.AddedBy) {
 		return nil, ErrInvalidOperator(msg.AddedBy)
 	}
 
@@ -37,7 +47,7 @@ func handleMsgAddProfiler(ctx sdk.Context, k Keeper, msg MsgAddProfiler) (*sdk.R
 }
 
 func handleMsgDeleteProfiler(ctx sdk.Context, k Keeper, msg MsgDeleteProfiler) (*sdk.Result, error) {
-	if profiler, found := k.GetProfiler(ctx, msg.DeletedBy); !found || profiler.AccountType != Genesis {
+	if !isOperator(ctx, k, msg.DeletedBy) {
 		return nil, ErrInvalidOperator(msg.DeletedBy)
 	}
 
@@ -56,3 +66,76 @@ func handleMsgDeleteProfiler(ctx sdk.Context, k Keeper, msg MsgDeleteProfiler) (
 	}
 	return &sdk.Result{}, nil
 }
+
+func handleMsgDisableRoute(ctx sdk.Context, k Keeper, msg MsgDisableRoute) (*sdk.Result, error) {
+	if !isOperator(ctx, k, msg.DisabledBy) {
+		return nil, ErrInvalidOperator(msg.DisabledBy)
+	}
+
+	// a guardian disabling the route again keeps the height it was disabled at, the route
+	// is not disabled for longer than MaxDisableBlocks without a passed governance proposal
+	byGov := msg.DisabledBy.Equals(govtypes.ModuleAddress)
+	disabledHeight := ctx.BlockHeight()
+	if disabledRoute, found := k.GetDisabledRoute(ctx, msg.Target); found && disabledRoute.IsActive(ctx.BlockHeight()) && !byGov {
+		if disabledRoute.IsDisabledByGov() {
+			return nil, ErrRouteDisabledByGov(msg.Target)
+		}
+		disabledHeight = disabledRoute.DisabledHeight
+	}
+
+	// a passed governance proposal can disable a route for longer than a guardian
+	if msg.ExpiryHeight <= ctx.BlockHeight() || (!byGov && msg.ExpiryHeight > disabledHeight+MaxDisableBlocks) {
+		return nil, ErrInvalidExpiryHeight(msg.ExpiryHeight)
+	}
+
+	k.SetDisabledRoute(ctx, NewDisabledRoute(msg.Target, disabledHeight, msg.ExpiryHeight, msg.DisabledBy))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisableRoute,
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", msg.ExpiryHeight)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DisabledBy.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgEnableRoute(ctx sdk.Context, k Keeper, msg MsgEnableRoute) (*sdk.Result, error) {
+	if !isOperator(ctx, k, msg.EnabledBy) {
+		return nil, ErrInvalidOperator(msg.EnabledBy)
+	}
+
+	disabledRoute, found := k.GetDisabledRoute(ctx, msg.Target)
+	if !found {
+		return nil, ErrRouteNotDisabled(msg.Target)
+	}
+
+	if disabledRoute.IsDisabledByGov() && !msg.EnabledBy.Equals(govtypes.ModuleAddress) {
+		return nil, ErrRouteDisabledByGov(msg.Target)
+	}
+
+	k.DeleteDisabledRoute(ctx, msg.Target)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEnableRoute,
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.EnabledBy.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// isOperator tells if addr can add and delete ordinary guardians, the genesis guardians can and
+// so can the governance module account when it executes a passed proposal
+func isOperator(ctx sdk.Context, k Keeper, addr sdk.AccAddress) bool {
+	if addr.Equals(govtypes.ModuleAddress) {
+		return true
+	}
+
+	profiler, found := k.GetProfiler(ctx, addr)
+	return found && profiler.AccountType == Genesis
+}
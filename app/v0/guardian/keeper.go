@@ -47,3 +47,47 @@ func (k Keeper) ProfilersIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, GetProfilersSubspaceKey())
 }
+
+func (k Keeper) SetDisabledRoute(ctx sdk.Context, disabledRoute DisabledRoute) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(disabledRoute)
+	store.Set(GetDisabledRouteKey(disabledRoute.Target), bz)
+}
+
+func (k Keeper) DeleteDisabledRoute(ctx sdk.Context, target string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDisabledRouteKey(target))
+}
+
+func (k Keeper) GetDisabledRoute(ctx sdk.Context, target string) (disabledRoute DisabledRoute, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDisabledRouteKey(target))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &disabledRoute)
+		return disabledRoute, true
+	}
+	return disabledRoute, false
+}
+
+// IsRouteDisabled tells if the target is disabled at the height of the context, a disabled
+// route is enabled again at its expiry height even before the end blocker deletes it
+func (k Keeper) IsRouteDisabled(ctx sdk.Context, target string) bool {
+	disabledRoute, found := k.GetDisabledRoute(ctx, target)
+	return found && disabledRoute.IsActive(ctx.BlockHeight())
+}
+
+func (k Keeper) DisabledRoutesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetDisabledRoutesSubspaceKey())
+}
+
+func (k Keeper) GetDisabledRoutes(ctx sdk.Context) (disabledRoutes DisabledRoutes) {
+	iterator := k.DisabledRoutesIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var disabledRoute DisabledRoute
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &disabledRoute)
+		disabledRoutes = append(disabledRoutes, disabledRoute)
+	}
+	return disabledRoutes
+}
@@ -129,5 +129,6 @@ func (a AppModule) BeginBlock(sdk.Context, types.RequestBeginBlock) {
 // EndBlock returns the end blocker for the guardian module. It returns no validator
 // updates.
 func (a AppModule) EndBlock(ctx sdk.Context, b types.RequestEndBlock) []types.ValidatorUpdate {
+	EndBlocker(ctx, a.keeper)
 	return nil
 }
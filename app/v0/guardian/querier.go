@@ -14,6 +14,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryProfilers:
 			return queryProfilers(ctx, k)
+		case QueryDisabledRoutes:
+			return queryDisabledRoutes(ctx, k)
 		default:
 			return nil, errors.New("unknown guardian query endpoint")
 		}
@@ -37,3 +39,11 @@ func queryProfilers(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 	return bz, nil
 }
+
+func queryDisabledRoutes(ctx sdk.Context, k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetDisabledRoutes(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
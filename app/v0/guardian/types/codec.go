@@ -1,12 +1,15 @@
 package types
 
 import (
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 )
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddProfiler{}, "dip/guardian/MsgAddProfiler", nil)
 	cdc.RegisterConcrete(MsgDeleteProfiler{}, "dip/guardian/MsgDeleteProfiler", nil)
+	cdc.RegisterConcrete(MsgDisableRoute{}, "dip/guardian/MsgDisableRoute", nil)
+	cdc.RegisterConcrete(MsgEnableRoute{}, "dip/guardian/MsgEnableRoute", nil)
 	cdc.RegisterConcrete(Guardian{}, "dip/guardian/Guardian", nil)
 }
 
@@ -14,4 +17,10 @@ var msgCdc = codec.New()
 
 func init() {
 	RegisterCodec(msgCdc)
+
+	// the messages can be executed by a governance proposal
+	govtypes.RegisterProposalMsgCodec(MsgAddProfiler{}, "dip/guardian/MsgAddProfiler")
+	govtypes.RegisterProposalMsgCodec(MsgDeleteProfiler{}, "dip/guardian/MsgDeleteProfiler")
+	govtypes.RegisterProposalMsgCodec(MsgDisableRoute{}, "dip/guardian/MsgDisableRoute")
+	govtypes.RegisterProposalMsgCodec(MsgEnableRoute{}, "dip/guardian/MsgEnableRoute")
 }
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// MaxDisableBlocks is the longest a genesis guardian can disable a route for counted from
+// the height it was disabled at, only a governance proposal can disable it for longer
+const MaxDisableBlocks int64 = 100000
+
+// DisabledRoute rejects the txs carrying the msgs of a route, the msgs of a type of a
+// route or the calls of a contract until the expiry height. The target is the route
+// ("vm"), the route and the type ("bank/send") or the bech32 address of the contract.
+// The disabled height is kept when the guardians disable the route again before it expires.
+type DisabledRoute struct {
+	Target         string         `json:"target"`
+	DisabledHeight int64          `json:"disabled_height"`
+	ExpiryHeight   int64          `json:"expiry_height"`
+	DisabledBy     sdk.AccAddress `json:"disabled_by"`
+}
+
+func NewDisabledRoute(target string, disabledHeight, expiryHeight int64, disabledBy sdk.AccAddress) DisabledRoute {
+	return DisabledRoute{
+		Target:         target,
+		DisabledHeight: disabledHeight,
+		ExpiryHeight:   expiryHeight,
+		DisabledBy:     disabledBy,
+	}
+}
+
+// IsActive tells if the route is still disabled at the height
+func (dr DisabledRoute) IsActive(height int64) bool {
+	return height < dr.ExpiryHeight
+}
+
+// IsDisabledByGov tells if the route was disabled by a passed governance proposal, only
+// another one can enable it or change its expiry height
+func (dr DisabledRoute) IsDisabledByGov() bool {
+	return dr.DisabledBy.Equals(govtypes.ModuleAddress)
+}
+
+func (dr DisabledRoute) String() string {
+	return fmt.Sprintf(`Disabled Route
+  Target:          %s
+  DisabledHeight:  %d
+  ExpiryHeight:    %d
+  DisabledBy:      %s`, dr.Target, dr.DisabledHeight, dr.ExpiryHeight, dr.DisabledBy)
+}
+
+type DisabledRoutes []DisabledRoute
+
+func (drs DisabledRoutes) String() (out string) {
+	if len(drs) == 0 {
+		return "[]"
+	}
+	for _, dr := range drs {
+		out += dr.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ValidateRouteTarget checks the target of a disabled route. The routes of the guardian
+// and gov modules can not be disabled as they are the ones to enable the routes again.
+func ValidateRouteTarget(target string) error {
+	if _, err := sdk.AccAddressFromBech32(target); err == nil {
+		return nil
+	}
+
+	parts := strings.Split(target, "/")
+	if len(parts) > 2 || !sdk.IsAlphaNumeric(parts[0]) {
+		return ErrInvalidRouteTarget(target)
+	}
+	if len(parts) == 2 && (len(parts[1]) == 0 || strings.ContainsAny(parts[1], " /")) {
+		return ErrInvalidRouteTarget(target)
+	}
+
+	if parts[0] == RouterKey || parts[0] == protocol.GovModuleName {
+		return ErrInvalidRouteTarget(target)
+	}
+	return nil
+}
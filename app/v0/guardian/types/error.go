@@ -17,6 +17,10 @@ const (
 	CodeAddressEmpty          = 120
 	CodeAddedByEmpty          = 121
 	CodeDeletedByEmpty        = 122
+	CodeInvalidRouteTarget    = 130
+	CodeInvalidExpiryHeight   = 131
+	CodeRouteNotDisabled      = 132
+	CodeRouteDisabledByGov    = 133
 )
 
 func ErrInvalidOperator(operator sdk.AccAddress) error {
@@ -50,3 +54,19 @@ func ErrAddedByEmpty() error {
 func ErrDeletedByEmpty() error {
 	return sdkerrors.New(ModuleName, CodeDeletedByEmpty, "deleted_by is empty")
 }
+
+func ErrInvalidRouteTarget(target string) error {
+	return sdkerrors.New(ModuleName, CodeInvalidRouteTarget, fmt.Sprintf("%s is not a route, a route and msg type or a contract address that can be disabled", target))
+}
+
+func ErrInvalidExpiryHeight(expiryHeight int64) error {
+	return sdkerrors.New(ModuleName, CodeInvalidExpiryHeight, fmt.Sprintf("expiry height %d should be above the current height and at most %d blocks after the route was disabled", expiryHeight, MaxDisableBlocks))
+}
+
+func ErrRouteNotDisabled(target string) error {
+	return sdkerrors.New(ModuleName, CodeRouteNotDisabled, fmt.Sprintf("%s is not disabled", target))
+}
+
+func ErrRouteDisabledByGov(target string) error {
+	return sdkerrors.New(ModuleName, CodeRouteDisabledByGov, fmt.Sprintf("%s is disabled by a governance proposal", target))
+}
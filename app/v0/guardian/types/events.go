@@ -0,0 +1,13 @@
+package types
+
+// guardian module event types
+const (
+	EventTypeDisableRoute = "disable_route"
+	EventTypeEnableRoute  = "enable_route"
+
+	AttributeKeyTarget       = "target"
+	AttributeKeyExpiryHeight = "expiry_height"
+	AttributeKeyReason       = "reason"
+
+	AttributeValueExpired = "expired"
+)
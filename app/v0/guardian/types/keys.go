@@ -13,7 +13,8 @@ const (
 )
 
 var (
-	profilerKey = []byte{0x00}
+	profilerKey      = []byte{0x00}
+	disabledRouteKey = []byte{0x01}
 )
 
 func GetProfilerKey(addr sdk.AccAddress) []byte {
@@ -23,3 +24,11 @@ func GetProfilerKey(addr sdk.AccAddress) []byte {
 func GetProfilersSubspaceKey() []byte {
 	return profilerKey
 }
+
+func GetDisabledRouteKey(target string) []byte {
+	return append(disabledRouteKey, []byte(target)...)
+}
+
+func GetDisabledRoutesSubspaceKey() []byte {
+	return disabledRouteKey
+}
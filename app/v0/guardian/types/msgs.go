@@ -4,7 +4,7 @@ import (
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
-var _, _ sdk.Msg = MsgAddProfiler{}, MsgDeleteProfiler{}
+var _, _, _, _ sdk.Msg = MsgAddProfiler{}, MsgDeleteProfiler{}, MsgDisableRoute{}, MsgEnableRoute{}
 
 type MsgAddProfiler struct {
 	AddGuardian
@@ -75,6 +75,85 @@ func (m MsgDeleteProfiler) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.DeletedBy}
 }
 
+// MsgDisableRoute disables a route, a msg type of a route or a contract until the expiry height
+type MsgDisableRoute struct {
+	Target       string         `json:"target"`
+	ExpiryHeight int64          `json:"expiry_height"`
+	DisabledBy   sdk.AccAddress `json:"disabled_by"`
+}
+
+func NewMsgDisableRoute(target string, expiryHeight int64, disabledBy sdk.AccAddress) MsgDisableRoute {
+	return MsgDisableRoute{
+		Target:       target,
+		ExpiryHeight: expiryHeight,
+		DisabledBy:   disabledBy,
+	}
+}
+
+func (m MsgDisableRoute) Route() string {
+	return RouterKey
+}
+
+func (m MsgDisableRoute) Type() string {
+	return "MsgDisableRoute"
+}
+
+func (m MsgDisableRoute) ValidateBasic() error {
+	if len(m.DisabledBy) == 0 {
+		return ErrAddressEmpty()
+	}
+	if m.ExpiryHeight <= 0 {
+		return ErrInvalidExpiryHeight(m.ExpiryHeight)
+	}
+	return ValidateRouteTarget(m.Target)
+}
+
+func (m MsgDisableRoute) GetSignBytes() []byte {
+	bz := msgCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgDisableRoute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.DisabledBy}
+}
+
+// MsgEnableRoute enables a disabled route before its expiry height
+type MsgEnableRoute struct {
+	Target    string         `json:"target"`
+	EnabledBy sdk.AccAddress `json:"enabled_by"`
+}
+
+func NewMsgEnableRoute(target string, enabledBy sdk.AccAddress) MsgEnableRoute {
+	return MsgEnableRoute{
+		Target:    target,
+		EnabledBy: enabledBy,
+	}
+}
+
+func (m MsgEnableRoute) Route() string {
+	return RouterKey
+}
+
+func (m MsgEnableRoute) Type() string {
+	return "MsgEnableRoute"
+}
+
+func (m MsgEnableRoute) ValidateBasic() error {
+	if len(m.EnabledBy) == 0 {
+		return ErrAddressEmpty()
+	}
+	return ValidateRouteTarget(m.Target)
+}
+
+func (m MsgEnableRoute) GetSignBytes() []byte {
+	bz := msgCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgEnableRoute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.EnabledBy}
+}
+
 type AddGuardian struct {
 	Description string         `json:"description"`
 	Address     sdk.AccAddress `json:"address"`
@@ -1,5 +1,6 @@
 package types
 
 const (
-	QueryProfilers = "profilers"
+	QueryProfilers      = "profilers"
+	QueryDisabledRoutes = "disabled_routes"
 )
@@ -5,6 +5,7 @@ import (
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
 )
 
 // GovKeeper return govKeeper
@@ -31,3 +32,8 @@ func (p *ProtocolV0) AccountKeeper() auth.AccountKeeper {
 func (p *ProtocolV0) SupplyKeeper() supply.Keeper {
 	return p.supplyKeeper
 }
+
+// VMKeeper return vmKeeper
+func (p *ProtocolV0) VMKeeper() vm.Keeper {
+	return p.vmKeeper
+}
@@ -1,6 +1,8 @@
 package v0
 
 import (
+	"fmt"
+
 	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
@@ -22,6 +24,7 @@ import (
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/slashing"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/upgrade"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/upgrade/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
@@ -32,6 +35,22 @@ import (
 
 var _ protocol.Protocol = (*ProtocolV0)(nil)
 
+// EthCompatibleVersion is the protocol version from which the vm hashes code with
+// keccak256 and derives contract addresses as Ethereum does
+const EthCompatibleVersion = 1
+
+// BerlinVersion is the protocol version from which the vm prices the accesses to accounts
+// and storage and takes access lists as the Berlin release of Ethereum does
+const BerlinVersion = 2
+
+// CancunVersion is the protocol version from which the vm runs PUSH0, BASEFEE, MCOPY, TLOAD
+// and TSTORE as the Cancun release of Ethereum does
+const CancunVersion = 3
+
+// StorageRentVersion is the protocol version from which the contracts pay the rent of
+// their storage
+const StorageRentVersion = 4
+
 // ModuleBasics - The module BasicManager is in charge of setting up basic,
 // non-dependant module elements, such as codec registration
 // and genesis verification.
@@ -51,6 +70,7 @@ var ModuleBasics = module.NewBasicManager(
 	vm.AppModuleBasic{},
 	upgrade.AppModuleBasic{},
 	guardian.AppModuleBasic{},
+	token.AppModuleBasic{},
 )
 
 var maccPerms = map[string][]string{
@@ -60,6 +80,8 @@ var maccPerms = map[string][]string{
 	staking.BondedPoolName:    {supply.Burner, supply.Staking},
 	staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 	gov.ModuleName:            {supply.Burner},
+	token.ModuleName:          {supply.Minter, supply.Burner},
+	vm.ModuleName:             nil,
 }
 
 // ProtocolV0 is the struct of the original protocol
@@ -86,6 +108,7 @@ type ProtocolV0 struct {
 	vmKeeper       vm.Keeper
 	upgradeKeeper  upgrade.Keeper
 	guardianKeeper guardian.Keeper
+	tokenKeeper    token.Keeper
 
 	router      sdk.Router
 	queryRouter sdk.QueryRouter
@@ -152,8 +175,33 @@ func (p *ProtocolV0) LoadContext() {
 	p.configFeeHandlers()
 }
 
-// Init
-func (p *ProtocolV0) Init() {
+// Init runs the migrations of the protocol when it gets activated
+func (p *ProtocolV0) Init(ctx sdk.Context) {
+	if p.version == EthCompatibleVersion {
+		n, err := p.vmKeeper.MigrateCodeHashes(ctx)
+		if err != nil {
+			panic(err)
+		}
+		p.logger.Info(fmt.Sprintf("migrated the code hashes of %d contracts", n))
+	}
+
+	if p.version == BerlinVersion {
+		// the constant gas of the opcodes accessing accounts and storage becomes the warm cost
+		p.vmKeeper.SetVMOpGasParams(ctx, vm.BerlinVMOpGasParams(p.vmKeeper.GetVMOpGasParams(ctx)))
+		p.logger.Info("migrated the vm op gas params to the berlin fork")
+	}
+
+	if p.version == CancunVersion {
+		// the opcodes added by the fork were invalid and had no constant gas
+		p.vmKeeper.SetVMOpGasParams(ctx, vm.CancunVMOpGasParams(p.vmKeeper.GetVMOpGasParams(ctx)))
+		p.logger.Info("migrated the vm op gas params to the cancun fork")
+	}
+
+	if p.version == StorageRentVersion {
+		// the slots written before the rent was introduced are not in the storage footprints
+		n := p.vmKeeper.MigrateStorageBytes(ctx)
+		p.logger.Info(fmt.Sprintf("migrated the storage footprints of %d contracts", n))
+	}
 }
 
 // GetCodec gets tx codec
@@ -228,19 +276,35 @@ func (p *ProtocolV0) configKeepers() {
 		p.cdc, protocol.Keys[slashing.StoreKey], &stakingKeeper, slashingSubspace)
 	p.crisisKeeper = crisis.NewKeeper(crisisSubspace, p.invCheckPeriod, p.supplyKeeper, auth.FeeCollectorName)
 
+	p.tokenKeeper = token.NewKeeper(p.accountKeeper, p.supplyKeeper)
+
+	p.guardianKeeper = guardian.NewKeeper(p.cdc, protocol.Keys[protocol.GuardianStoreKey])
+
 	p.vmKeeper = vm.NewKeeper(
 		p.cdc,
 		protocol.Keys[protocol.VMStoreKey],
 		vmSubspace,
 		p.accountKeeper,
+		p.bankKeeper,
+		p.supplyKeeper,
+		&stakingKeeper,
+		p.distrKeeper,
+		p.guardianKeeper,
 	)
-
-	p.guardianKeeper = guardian.NewKeeper(p.cdc, protocol.Keys[protocol.GuardianStoreKey])
+	p.vmKeeper.SetEthCompatible(p.version >= EthCompatibleVersion)
+	switch {
+	case p.version >= CancunVersion:
+		p.vmKeeper.SetFork(vm.ForkCancun)
+	case p.version >= BerlinVersion:
+		p.vmKeeper.SetFork(vm.ForkBerlin)
+	}
+	p.vmKeeper.SetStorageRent(p.version >= StorageRentVersion)
 
 	p.govKeeper = gov.NewKeeper(
 		p.cdc, protocol.Keys[gov.StoreKey], govSubspace, p.supplyKeeper,
 		&stakingKeeper, p.guardianKeeper, p.protocolKeeper,
 	)
+	p.govKeeper.SetMsgRouter(p.router)
 
 	govRouter := gov.NewRouter()
 	govRouter.
@@ -277,6 +341,7 @@ func (p *ProtocolV0) configModuleManager() {
 		vm.NewAppModule(p.vmKeeper),
 		upgrade.NewAppModule(p.upgradeKeeper),
 		guardian.NewAppModule(p.guardianKeeper),
+		token.NewAppModule(p.tokenKeeper),
 	)
 
 	moduleManager.SetOrderBeginBlockers(
@@ -289,6 +354,7 @@ func (p *ProtocolV0) configModuleManager() {
 		gov.ModuleName,
 		staking.ModuleName,
 		vm.ModuleName,
+		guardian.ModuleName,
 		upgrade.ModuleName,
 	)
 
@@ -310,6 +376,7 @@ func (p *ProtocolV0) configModuleManager() {
 		types.ModuleName,
 		guardian.ModuleName,
 		upgrade.ModuleName,
+		token.ModuleName,
 	)
 
 	p.moduleManager = moduleManager
@@ -366,7 +433,14 @@ func (p *ProtocolV0) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 }
 
 func (p *ProtocolV0) configFeeHandlers() {
-	p.anteHandler = ante.NewAnteHandler(p.accountKeeper, p.supplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := vm.NewAnteHandler(p.accountKeeper, p.supplyKeeper, p.vmKeeper,
+		ante.NewAnteHandler(p.accountKeeper, p.supplyKeeper, ante.DefaultSigVerificationGasConsumer))
+
+	// the routes disabled by the guardians are checked for the std and the eth txs
+	cbd := ante.NewCircuitBreakerDecorator(p.guardianKeeper)
+	p.anteHandler = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return cbd.AnteHandle(ctx, tx, simulate, anteHandler)
+	}
 	p.feeRefundHandler = auth.NewFeeRefundHandler(p.accountKeeper, p.supplyKeeper, p.refundKeeper)
 }
 
@@ -17,6 +17,8 @@ const (
 	Minter       = types.Minter
 	Burner       = types.Burner
 	Staking      = types.Staking
+
+	MaxTokenDecimals = types.MaxTokenDecimals
 )
 
 var (
@@ -35,6 +37,8 @@ var (
 	DefaultGenesisState   = types.DefaultGenesisState
 	NewSupply             = types.NewSupply
 	DefaultSupply         = types.DefaultSupply
+	NewTokenMeta          = types.NewTokenMeta
+	TokenContractAddress  = types.TokenContractAddress
 
 	// variable aliases
 	DefaultCodespace = keeper.DefaultCodespace
@@ -47,6 +51,7 @@ type (
 	GenesisState  = types.GenesisState
 	Supply        = types.Supply
 	Vesting       = types.Vesting
+	TokenMeta     = types.TokenMeta
 )
 
 var (
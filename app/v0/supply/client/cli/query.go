@@ -27,6 +27,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	supplyQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
 		GetCmdQueryTotalVesting(cdc),
+		GetCmdQueryToken(cdc),
+		GetCmdQueryTokens(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -139,3 +141,67 @@ func queryTotalVesting(cliCtx context.CLIContext, cdc *codec.Codec) error {
 
 	return cliCtx.PrintOutput(totalVesting)
 }
+
+// GetCmdQueryToken implements the query token metadata command.
+func GetCmdQueryToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the metadata of an issued token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the owner, decimals, max supply and vm contract address of an issued token.
+
+Example:
+$ %s query %s token usdt
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenMetaParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenMeta), bz)
+			if err != nil {
+				return err
+			}
+
+			var meta types.TokenMeta
+			err = cdc.UnmarshalJSON(res, &meta)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(meta)
+		},
+	}
+}
+
+// GetCmdQueryTokens implements the query all token metadata command.
+func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens",
+		Args:  cobra.NoArgs,
+		Short: "Query the metadata of all the issued tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenMetas), nil)
+			if err != nil {
+				return err
+			}
+
+			var metas types.TokenMetas
+			err = cdc.UnmarshalJSON(res, &metas)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(metas)
+		},
+	}
+}
@@ -26,3 +26,13 @@ type SupplyI interface {
 	String() string
 	ValidateBasic() error
 }
+
+// TokenMetaI defines the metadata of a denom issued on chain, the denom is exposed
+// to the vm as an ERC-20 contract
+type TokenMetaI interface {
+	GetDenom() string
+	GetOwner() sdk.AccAddress
+	GetDecimals() uint8
+	GetMaxSupply() sdk.Int
+	GetContractAddress() sdk.AccAddress
+}
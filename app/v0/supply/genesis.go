@@ -1,6 +1,8 @@
 package supply
 
 import (
+	"fmt"
+
 	autypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/internal/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -50,15 +52,42 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, ak types.AccountKeeper, data Ge
 	}
 
 	keeper.SetSupply(ctx, types.NewSupply(data.Supply))
+
+	for _, meta := range data.Tokens {
+		keeper.SetTokenMeta(ctx, meta)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSupply(ctx).GetTotal())
+	tokens := keeper.GetAllTokenMetas(ctx)
+	if tokens == nil {
+		tokens = []TokenMeta{}
+	}
+	return NewGenesisState(keeper.GetSupply(ctx).GetTotal(), tokens)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	return types.NewSupply(data.Supply).ValidateBasic()
+	if err := types.NewSupply(data.Supply).ValidateBasic(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool, len(data.Tokens))
+	for _, meta := range data.Tokens {
+		if err := meta.ValidateBasic(); err != nil {
+			return err
+		}
+		if denoms[meta.Denom] {
+			return fmt.Errorf("duplicate token %s", meta.Denom)
+		}
+		denoms[meta.Denom] = true
+
+		if !meta.CanSupply(data.Supply.AmountOf(meta.Denom)) {
+			return fmt.Errorf("supply of token %s exceeds its max supply %s", meta.Denom, meta.MaxSupply)
+		}
+	}
+
+	return nil
 }
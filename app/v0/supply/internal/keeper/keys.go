@@ -11,12 +11,27 @@ var DefaultCodespace = types.ModuleName
 // Keys for supply store
 // Items are stored with the following key: values
 // - 0x00: Supply
+// - 0x01<addr>: Vesting
+// - 0x02<denom>: TokenMeta
+// - 0x03<contract_addr>: denom
 var (
-	SupplyKey             = []byte{0x00}
-	VestingStoreKeyPrefix = []byte{0x01}
+	SupplyKey              = []byte{0x00}
+	VestingStoreKeyPrefix  = []byte{0x01}
+	TokenMetaKeyPrefix     = []byte{0x02}
+	TokenContractKeyPrefix = []byte{0x03}
 )
 
 // VestingStoreKey turn an address to key used to get it from the supply store
 func VestingStoreKey(addr sdk.AccAddress) []byte {
 	return append(VestingStoreKeyPrefix, addr.Bytes()...)
 }
+
+// TokenMetaKey returns the key of the metadata of an issued denom
+func TokenMetaKey(denom string) []byte {
+	return append(TokenMetaKeyPrefix, []byte(denom)...)
+}
+
+// TokenContractKey returns the key mapping the contract address of an issued denom to the denom
+func TokenContractKey(addr sdk.AccAddress) []byte {
+	return append(TokenContractKeyPrefix, addr.Bytes()...)
+}
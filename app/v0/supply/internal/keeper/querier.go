@@ -5,6 +5,7 @@ import (
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/internal/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/client"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
 )
@@ -23,6 +24,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryTokenMeta:
+			return queryTokenMeta(ctx, req, k)
+
+		case types.QueryTokenMetas:
+			return queryTokenMetas(ctx, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -83,3 +90,38 @@ func queryTotalVesting(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte,
 
 	return res, nil
 }
+
+func queryTokenMeta(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTokenMetaParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	meta, found := k.GetTokenMeta(ctx, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "token %s not issued", params.Denom)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, meta)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTokenMetas(ctx sdk.Context, k Keeper) ([]byte, error) {
+	metas := k.GetAllTokenMetas(ctx)
+	if metas == nil {
+		metas = []types.TokenMeta{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, metas)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
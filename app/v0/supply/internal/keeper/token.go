@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/internal/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// GetTokenMeta returns the metadata of an issued denom
+func (k Keeper) GetTokenMeta(ctx sdk.Context, denom string) (meta types.TokenMeta, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(TokenMetaKey(denom))
+	if bz == nil {
+		return meta, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &meta)
+	return meta, true
+}
+
+// SetTokenMeta sets the metadata of an issued denom and maps its contract address to it
+func (k Keeper) SetTokenMeta(ctx sdk.Context, meta types.TokenMeta) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(TokenMetaKey(meta.Denom), k.cdc.MustMarshalBinaryBare(meta))
+	store.Set(TokenContractKey(meta.GetContractAddress()), []byte(meta.Denom))
+}
+
+// GetTokenMetaByContract returns the metadata of the denom exposed to the vm at a contract address
+func (k Keeper) GetTokenMetaByContract(ctx sdk.Context, addr sdk.AccAddress) (exported.TokenMetaI, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(TokenContractKey(addr))
+	if bz == nil {
+		return nil, false
+	}
+
+	meta, found := k.GetTokenMeta(ctx, string(bz))
+	if !found {
+		return nil, false
+	}
+	return meta, true
+}
+
+// IterateTokenMetas iterates over the metadata of the issued denoms in the order of the denoms
+func (k Keeper) IterateTokenMetas(ctx sdk.Context, process func(types.TokenMeta) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, TokenMetaKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var meta types.TokenMeta
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &meta)
+
+		if process(meta) {
+			return
+		}
+	}
+}
+
+// GetAllTokenMetas returns the metadata of all the issued denoms
+func (k Keeper) GetAllTokenMetas(ctx sdk.Context) (metas []types.TokenMeta) {
+	k.IterateTokenMetas(ctx, func(meta types.TokenMeta) bool {
+		metas = append(metas, meta)
+		return false
+	})
+	return
+}
@@ -6,15 +6,16 @@ import (
 
 // GenesisState is the supply state that must be provided at genesis.
 type GenesisState struct {
-	Supply sdk.Coins `json:"supply" yaml:"supply"`
+	Supply sdk.Coins   `json:"supply" yaml:"supply"`
+	Tokens []TokenMeta `json:"tokens" yaml:"tokens"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(supply sdk.Coins) GenesisState {
-	return GenesisState{supply}
+func NewGenesisState(supply sdk.Coins, tokens []TokenMeta) GenesisState {
+	return GenesisState{supply, tokens}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultSupply().GetTotal(), []TokenMeta{})
 }
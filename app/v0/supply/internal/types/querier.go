@@ -5,6 +5,8 @@ const (
 	QueryTotalSupply  = "total_supply"
 	QuerySupplyOf     = "supply_of"
 	QueryTotalVesting = "total_vestings"
+	QueryTokenMeta    = "token_meta"
+	QueryTokenMetas   = "token_metas"
 )
 
 // QueryTotalSupply defines the params for the following queries:
@@ -31,3 +33,15 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// QueryTokenMetaParams defines the params for the following queries:
+//
+// - 'custom/supply/token_meta'
+type QueryTokenMetaParams struct {
+	Denom string
+}
+
+// NewQueryTokenMetaParams creates a new instance to query the metadata of an issued denom
+func NewQueryTokenMetaParams(denom string) QueryTokenMetaParams {
+	return QueryTokenMetaParams{denom}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// MaxTokenDecimals is the largest number of decimals of an issued token
+const MaxTokenDecimals = 18
+
+var _ exported.TokenMetaI = TokenMeta{}
+
+// TokenMeta is the metadata of a denom issued on chain
+type TokenMeta struct {
+	Denom    string         `json:"denom" yaml:"denom"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Decimals uint8          `json:"decimals" yaml:"decimals"`
+	// MaxSupply caps the total supply of the denom, zero means no cap
+	MaxSupply sdk.Int `json:"max_supply" yaml:"max_supply"`
+}
+
+// NewTokenMeta creates a new TokenMeta instance
+func NewTokenMeta(denom string, owner sdk.AccAddress, decimals uint8, maxSupply sdk.Int) TokenMeta {
+	return TokenMeta{
+		Denom:     denom,
+		Owner:     owner,
+		Decimals:  decimals,
+		MaxSupply: maxSupply,
+	}
+}
+
+// TokenContractAddress returns the address the ERC-20 contract of a denom is deployed at
+func TokenContractAddress(denom string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/token/%s", ModuleName, denom))))
+}
+
+// GetDenom implements exported.TokenMetaI
+func (tm TokenMeta) GetDenom() string { return tm.Denom }
+
+// GetOwner implements exported.TokenMetaI
+func (tm TokenMeta) GetOwner() sdk.AccAddress { return tm.Owner }
+
+// GetDecimals implements exported.TokenMetaI
+func (tm TokenMeta) GetDecimals() uint8 { return tm.Decimals }
+
+// GetMaxSupply implements exported.TokenMetaI
+func (tm TokenMeta) GetMaxSupply() sdk.Int { return tm.MaxSupply }
+
+// GetContractAddress implements exported.TokenMetaI
+func (tm TokenMeta) GetContractAddress() sdk.AccAddress { return TokenContractAddress(tm.Denom) }
+
+// CanSupply returns whether the total supply of the denom may reach amount
+func (tm TokenMeta) CanSupply(amount sdk.Int) bool {
+	return tm.MaxSupply.IsZero() || amount.LTE(tm.MaxSupply)
+}
+
+// ValidateBasic validates the token metadata
+func (tm TokenMeta) ValidateBasic() error {
+	if err := sdk.ValidateDenom(tm.Denom); err != nil {
+		return err
+	}
+	if tm.Denom == sdk.NativeTokenName {
+		return fmt.Errorf("the native token %s can not be issued", sdk.NativeTokenName)
+	}
+	if tm.Owner.Empty() {
+		return fmt.Errorf("token %s has no owner", tm.Denom)
+	}
+	if tm.Decimals > MaxTokenDecimals {
+		return fmt.Errorf("token %s has %d decimals, at most %d", tm.Denom, tm.Decimals, MaxTokenDecimals)
+	}
+	if tm.MaxSupply == (sdk.Int{}) || tm.MaxSupply.IsNegative() {
+		return fmt.Errorf("token %s has an invalid max supply", tm.Denom)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (tm TokenMeta) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Token:
+  Denom:      %s
+  Owner:      %s
+  Decimals:   %d
+  Max Supply: %s
+  Contract:   %s`, tm.Denom, tm.Owner, tm.Decimals, tm.MaxSupply, tm.GetContractAddress()))
+}
+
+// TokenMetas is a slice of TokenMeta
+type TokenMetas []TokenMeta
+
+// String implements fmt.Stringer
+func (tms TokenMetas) String() string {
+	out := make([]string, len(tms))
+	for i, tm := range tms {
+		out[i] = tm.String()
+	}
+	return strings.Join(out, "\n")
+}
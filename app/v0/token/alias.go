@@ -0,0 +1,44 @@
+package token
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+	RouterKey  = types.RouterKey
+
+	EventTypeIssueToken    = types.EventTypeIssueToken
+	EventTypeMintToken     = types.EventTypeMintToken
+	EventTypeBurnToken     = types.EventTypeBurnToken
+	AttributeKeyDenom      = types.AttributeKeyDenom
+	AttributeKeyOwner      = types.AttributeKeyOwner
+	AttributeKeyRecipient  = types.AttributeKeyRecipient
+	AttributeKeyContract   = types.AttributeKeyContract
+	AttributeValueCategory = types.AttributeValueCategory
+)
+
+type (
+	Keeper        = keeper.Keeper
+	MsgIssueToken = types.MsgIssueToken
+	MsgMintToken  = types.MsgMintToken
+	MsgBurnToken  = types.MsgBurnToken
+)
+
+var (
+	// functions aliases
+	NewKeeper        = keeper.NewKeeper
+	NewMsgIssueToken = types.NewMsgIssueToken
+	NewMsgMintToken  = types.NewMsgMintToken
+	NewMsgBurnToken  = types.NewMsgBurnToken
+	RegisterCodec    = types.RegisterCodec
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	ErrInvalidToken      = types.ErrInvalidToken
+	ErrTokenExists       = types.ErrTokenExists
+	ErrTokenNotFound     = types.ErrTokenNotFound
+	ErrNotTokenOwner     = types.ErrNotTokenOwner
+	ErrMaxSupplyExceeded = types.ErrMaxSupplyExceeded
+)
@@ -0,0 +1,6 @@
+package cli
+
+const (
+	FlagDecimals  = "decimals"
+	FlagMaxSupply = "max-supply"
+)
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/client/utils"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// GetTxCmd returns the transaction commands for the token module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "token transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		GetCmdIssueToken(cdc),
+		GetCmdMintToken(cdc),
+		GetCmdBurnToken(cdc),
+	)...)
+
+	return txCmd
+}
+
+func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "issue [denom] [amount]",
+		Short:   "Issue a new token and mint its initial supply to the sender",
+		Example: "dipcli token issue mytoken 1000000 --decimals=6 --max-supply=21000000 --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			maxSupply, ok := sdk.NewIntFromString(viper.GetString(FlagMaxSupply))
+			if !ok {
+				return fmt.Errorf("invalid max supply %s", viper.GetString(FlagMaxSupply))
+			}
+
+			decimals := viper.GetUint(FlagDecimals)
+			if decimals > supply.MaxTokenDecimals {
+				return fmt.Errorf("a token has at most %d decimals", supply.MaxTokenDecimals)
+			}
+
+			msg := types.NewMsgIssueToken(cliCtx.GetFromAddress(), args[0], uint8(decimals), maxSupply, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint(FlagDecimals, 0, "number of decimals of the token")
+	cmd.Flags().String(FlagMaxSupply, "0", "max total supply of the token, 0 for no cap")
+
+	return cmd
+}
+
+func GetCmdMintToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "mint [to] [amount]",
+		Short:   "Mint an issued token, only the owner of the token may mint",
+		Example: "dipcli token mint dip1... 1000mytoken --from=<key-name>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintToken(cliCtx.GetFromAddress(), to, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdBurnToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "burn [amount]",
+		Short:   "Burn an issued token held by the sender",
+		Example: "dipcli token burn 1000mytoken --from=<key-name>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnToken(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
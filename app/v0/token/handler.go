@@ -0,0 +1,103 @@
+package token
+
+import (
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// NewHandler returns a handler for "token" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgIssueToken:
+			return handleMsgIssueToken(ctx, msg, k)
+		case MsgMintToken:
+			return handleMsgMintToken(ctx, msg, k)
+		case MsgBurnToken:
+			return handleMsgBurnToken(ctx, msg, k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgIssueToken(ctx sdk.Context, msg MsgIssueToken, k Keeper) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	meta := msg.TokenMeta()
+	if err := k.IssueToken(ctx, meta, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeIssueToken,
+			sdk.NewAttribute(AttributeKeyDenom, meta.Denom),
+			sdk.NewAttribute(AttributeKeyOwner, meta.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(AttributeKeyContract, meta.GetContractAddress().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgMintToken(ctx sdk.Context, msg MsgMintToken, k Keeper) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.MintToken(ctx, msg.Owner, msg.To, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeMintToken,
+			sdk.NewAttribute(AttributeKeyDenom, msg.Amount.Denom),
+			sdk.NewAttribute(AttributeKeyRecipient, msg.To.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBurnToken(ctx sdk.Context, msg MsgBurnToken, k Keeper) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnToken(ctx, msg.From, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeBurnToken,
+			sdk.NewAttribute(AttributeKeyDenom, msg.Amount.Denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,92 @@
+package token
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/keeper"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	h := NewHandler(Keeper{})
+
+	res, err := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
+	require.NotNil(t, err)
+	require.Nil(t, res)
+	require.True(t, strings.Contains(err.Error(), "unrecognized token message type"))
+}
+
+func TestIssueMintBurn(t *testing.T) {
+	ctx, ak, sk, k := keep.CreateTestInput(t)
+	h := NewHandler(k)
+	owner, other := keep.Addrs[0], keep.Addrs[1]
+
+	_, err := h(ctx, NewMsgIssueToken(owner, "usdx", 6, sdk.NewInt(1000), sdk.NewInt(600)))
+	require.NoError(t, err)
+
+	meta, found := k.GetTokenMeta(ctx, "usdx")
+	require.True(t, found)
+	require.Equal(t, owner, meta.Owner)
+	require.Equal(t, uint8(6), meta.Decimals)
+	require.Equal(t, sdk.NewInt(600), ak.GetAccount(ctx, owner).GetCoins().AmountOf("usdx"))
+	require.Equal(t, sdk.NewInt(600), sk.GetSupply(ctx).GetTotal().AmountOf("usdx"))
+
+	// the contract account survives the vm removing empty accounts
+	contract := ak.GetAccount(ctx, supply.TokenContractAddress("usdx"))
+	require.NotNil(t, contract)
+	require.Equal(t, uint64(1), contract.GetSequence())
+
+	// a denom is issued once
+	_, err = h(ctx, NewMsgIssueToken(other, "usdx", 6, sdk.ZeroInt(), sdk.NewInt(1)))
+	require.True(t, ErrTokenExists.Is(err))
+
+	// only the owner mints, up to the max supply
+	_, err = h(ctx, NewMsgMintToken(other, other, sdk.NewInt64Coin("usdx", 100)))
+	require.True(t, ErrNotTokenOwner.Is(err))
+	_, err = h(ctx, NewMsgMintToken(owner, other, sdk.NewInt64Coin("usdx", 401)))
+	require.True(t, ErrMaxSupplyExceeded.Is(err))
+	_, err = h(ctx, NewMsgMintToken(owner, other, sdk.NewInt64Coin("usdx", 400)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(400), ak.GetAccount(ctx, other).GetCoins().AmountOf("usdx"))
+	require.Equal(t, sdk.NewInt(1000), sk.GetSupply(ctx).GetTotal().AmountOf("usdx"))
+
+	_, err = h(ctx, NewMsgMintToken(owner, other, sdk.NewInt64Coin("eurx", 1)))
+	require.True(t, ErrTokenNotFound.Is(err))
+
+	// burning frees room under the max supply
+	_, err = h(ctx, NewMsgBurnToken(other, sdk.NewInt64Coin("usdx", 401)))
+	require.Error(t, err)
+	_, err = h(ctx, NewMsgBurnToken(other, sdk.NewInt64Coin("usdx", 150)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(250), ak.GetAccount(ctx, other).GetCoins().AmountOf("usdx"))
+	require.Equal(t, sdk.NewInt(850), sk.GetSupply(ctx).GetTotal().AmountOf("usdx"))
+
+	_, err = h(ctx, NewMsgMintToken(owner, owner, sdk.NewInt64Coin("usdx", 150)))
+	require.NoError(t, err)
+}
+
+func TestIssueTokenValidation(t *testing.T) {
+	owner := keep.Addrs[0]
+
+	require.NoError(t, NewMsgIssueToken(owner, "usdx", 18, sdk.ZeroInt(), sdk.NewInt(10)).ValidateBasic())
+	require.Error(t, NewMsgIssueToken(owner, sdk.NativeTokenName, 18, sdk.ZeroInt(), sdk.NewInt(10)).ValidateBasic())
+	require.Error(t, NewMsgIssueToken(owner, "usdx", 19, sdk.ZeroInt(), sdk.NewInt(10)).ValidateBasic())
+	require.Error(t, NewMsgIssueToken(owner, "usdx", 6, sdk.NewInt(5), sdk.NewInt(10)).ValidateBasic())
+	require.Error(t, NewMsgIssueToken(nil, "usdx", 6, sdk.ZeroInt(), sdk.NewInt(10)).ValidateBasic())
+	require.Error(t, NewMsgMintToken(owner, owner, sdk.NewInt64Coin(sdk.NativeTokenName, 10)).ValidateBasic())
+	require.Error(t, NewMsgBurnToken(owner, sdk.NewInt64Coin("usdx", 0)).ValidateBasic())
+}
+
+func TestIssueTakenDenom(t *testing.T) {
+	ctx, _, sk, k := keep.CreateTestInput(t)
+	sk.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1))))
+
+	_, err := NewHandler(k)(ctx, NewMsgIssueToken(keep.Addrs[0], "usdx", 6, sdk.ZeroInt(), sdk.NewInt(10)))
+	require.True(t, ErrTokenExists.Is(err))
+}
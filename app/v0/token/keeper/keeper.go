@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// Keeper of the token module. The metadata of the issued tokens is kept by the supply
+// module, the token module holds no store of its own.
+type Keeper struct {
+	ak types.AccountKeeper
+	sk types.SupplyKeeper
+}
+
+// NewKeeper returns token keeper
+func NewKeeper(ak types.AccountKeeper, sk types.SupplyKeeper) Keeper {
+	return Keeper{
+		ak: ak,
+		sk: sk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
+}
+
+// GetTokenMeta returns the metadata of an issued token
+func (k Keeper) GetTokenMeta(ctx sdk.Context, denom string) (supply.TokenMeta, bool) {
+	return k.sk.GetTokenMeta(ctx, denom)
+}
+
+// IssueToken records the metadata of a new denom, deploys its contract and mints amount
+// of it to the owner
+func (k Keeper) IssueToken(ctx sdk.Context, meta supply.TokenMeta, amount sdk.Int) error {
+	if _, found := k.sk.GetTokenMeta(ctx, meta.Denom); found {
+		return sdkerrors.Wrapf(types.ErrTokenExists, "denom %s", meta.Denom)
+	}
+	// denoms minted by other modules, e.g. through genesis, can not be taken over
+	if k.sk.GetSupply(ctx).GetTotal().AmountOf(meta.Denom).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrTokenExists, "denom %s already has a supply", meta.Denom)
+	}
+	if !meta.CanSupply(amount) {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "issued amount %s, max supply %s", amount, meta.MaxSupply)
+	}
+
+	k.sk.SetTokenMeta(ctx, meta)
+	k.deployContract(ctx, meta.GetContractAddress())
+
+	if amount.IsPositive() {
+		return k.mint(ctx, meta.Owner, sdk.NewCoin(meta.Denom, amount))
+	}
+	return nil
+}
+
+// MintToken mints amount of an issued token to the account to, only the owner of the
+// token may mint
+func (k Keeper) MintToken(ctx sdk.Context, owner, to sdk.AccAddress, amount sdk.Coin) error {
+	meta, found := k.sk.GetTokenMeta(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "denom %s", amount.Denom)
+	}
+	if !meta.Owner.Equals(owner) {
+		return sdkerrors.Wrapf(types.ErrNotTokenOwner, "denom %s is owned by %s", meta.Denom, meta.Owner)
+	}
+
+	total := k.sk.GetSupply(ctx).GetTotal().AmountOf(amount.Denom).Add(amount.Amount)
+	if !meta.CanSupply(total) {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "total supply %s, max supply %s", total, meta.MaxSupply)
+	}
+
+	return k.mint(ctx, to, amount)
+}
+
+// BurnToken burns amount of an issued token from the account from
+func (k Keeper) BurnToken(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coin) error {
+	if _, found := k.sk.GetTokenMeta(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "denom %s", amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.sk.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+func (k Keeper) mint(ctx sdk.Context, to sdk.AccAddress, amount sdk.Coin) error {
+	coins := sdk.NewCoins(amount)
+	if err := k.sk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+}
+
+// deployContract creates the account of the contract of a token. Its sequence is set so
+// that the vm does not delete the account, and the allowances stored in it, as empty.
+func (k Keeper) deployContract(ctx sdk.Context, addr sdk.AccAddress) {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = k.ak.NewAccountWithAddress(ctx, addr)
+	}
+	if acc.GetSequence() == 0 {
+		if err := acc.SetSequence(1); err != nil {
+			panic(err)
+		}
+	}
+	k.ak.SetAccount(ctx, acc)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/bank"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/params"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	"github.com/Dipper-Labs/Dipper-Protocol/store"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+var (
+	Addrs = createTestAddrs(10)
+
+	maccPerms = map[string][]string{
+		types.ModuleName: {supply.Minter, supply.Burner},
+	}
+)
+
+// create a codec used only for testing
+func MakeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return cdc
+}
+
+func CreateTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, supply.Keeper, Keeper) {
+	keys := sdk.NewKVStoreKeys(auth.StoreKey, supply.StoreKey, params.StoreKey)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+	for _, key := range tkeys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeTransient, nil)
+	}
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0), ChainID: "foochainid"}, false, log.NewTMLogger(os.Stdout))
+
+	cdc := MakeTestCodec()
+	paramsKeeper := params.NewKeeper(cdc, keys[params.StoreKey], tkeys[params.TStoreKey])
+	accountKeeper := auth.NewAccountKeeper(cdc, keys[auth.StoreKey], paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	bankKeeper.SetSendEnabled(ctx, true)
+	supplyKeeper := supply.NewKeeper(cdc, keys[supply.StoreKey], accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	return ctx, accountKeeper, supplyKeeper, NewKeeper(accountKeeper, supplyKeeper)
+}
+
+func createTestAddrs(numAddrs int) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, numAddrs)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	return addrs
+}
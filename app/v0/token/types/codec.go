@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+)
+
+// RegisterCodec - register the sdk message type
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueToken{}, "dip/MsgIssueToken", nil)
+	cdc.RegisterConcrete(MsgMintToken{}, "dip/MsgMintToken", nil)
+	cdc.RegisterConcrete(MsgBurnToken{}, "dip/MsgBurnToken", nil)
+}
+
+// ModuleCdc - generic sealed codec to be used throughout this module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
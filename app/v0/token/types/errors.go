@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+var (
+	ErrInvalidToken      = sdkerrors.New(ModuleName, 1, "invalid token")
+	ErrTokenExists       = sdkerrors.New(ModuleName, 2, "token already exists")
+	ErrTokenNotFound     = sdkerrors.New(ModuleName, 3, "token not issued")
+	ErrNotTokenOwner     = sdkerrors.New(ModuleName, 4, "not the owner of the token")
+	ErrMaxSupplyExceeded = sdkerrors.New(ModuleName, 5, "max supply of the token exceeded")
+)
@@ -0,0 +1,15 @@
+package types
+
+// token module event types
+const (
+	EventTypeIssueToken = "issue_token"
+	EventTypeMintToken  = "mint_token"
+	EventTypeBurnToken  = "burn_token"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyContract  = "contract"
+
+	AttributeValueCategory = ModuleName
+)
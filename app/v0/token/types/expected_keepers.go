@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/exported"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	supplyexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// AccountKeeper defines the expected account keeper used for token
+type AccountKeeper interface {
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) exported.Account
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
+	SetAccount(ctx sdk.Context, acc exported.Account)
+}
+
+// SupplyKeeper defines the expected supply keeper used for token
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	GetTokenMeta(ctx sdk.Context, denom string) (supply.TokenMeta, bool)
+	SetTokenMeta(ctx sdk.Context, meta supply.TokenMeta)
+
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
+)
+
+const (
+	// ModuleName is the name of the token module, it is also the name of the module
+	// account the issued coins are minted to and burned from
+	ModuleName = protocol.TokenModuleName
+	RouterKey  = ModuleName
+)
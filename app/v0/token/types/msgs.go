@@ -0,0 +1,154 @@
+package types
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+const (
+	TypeMsgIssueToken = "issue_token"
+	TypeMsgMintToken  = "mint_token"
+	TypeMsgBurnToken  = "burn_token"
+)
+
+var (
+	_ sdk.Msg = MsgIssueToken{}
+	_ sdk.Msg = MsgMintToken{}
+	_ sdk.Msg = MsgBurnToken{}
+)
+
+// MsgIssueToken issues a new denom owned by Owner and mints Amount of it to the owner
+type MsgIssueToken struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	Decimals uint8          `json:"decimals" yaml:"decimals"`
+	// MaxSupply caps the total supply of the denom, zero means no cap
+	MaxSupply sdk.Int `json:"max_supply" yaml:"max_supply"`
+	Amount    sdk.Int `json:"amount" yaml:"amount"`
+}
+
+func NewMsgIssueToken(owner sdk.AccAddress, denom string, decimals uint8, maxSupply, amount sdk.Int) MsgIssueToken {
+	return MsgIssueToken{
+		Owner:     owner,
+		Denom:     denom,
+		Decimals:  decimals,
+		MaxSupply: maxSupply,
+		Amount:    amount,
+	}
+}
+
+func (msg MsgIssueToken) Route() string { return RouterKey }
+
+func (msg MsgIssueToken) Type() string { return TypeMsgIssueToken }
+
+func (msg MsgIssueToken) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing owner address")
+	}
+	if err := msg.TokenMeta().ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if msg.Amount == (sdk.Int{}) || msg.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "issued amount must not be negative")
+	}
+	if !msg.TokenMeta().CanSupply(msg.Amount) {
+		return sdkerrors.Wrapf(ErrMaxSupplyExceeded, "issued amount %s, max supply %s", msg.Amount, msg.MaxSupply)
+	}
+	return nil
+}
+
+func (msg MsgIssueToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgIssueToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// TokenMeta returns the metadata of the issued token
+func (msg MsgIssueToken) TokenMeta() supply.TokenMeta {
+	return supply.NewTokenMeta(msg.Denom, msg.Owner, msg.Decimals, msg.MaxSupply)
+}
+
+// MsgMintToken mints Amount of a token issued by Owner to the account To
+type MsgMintToken struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	To     sdk.AccAddress `json:"to" yaml:"to"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgMintToken(owner, to sdk.AccAddress, amount sdk.Coin) MsgMintToken {
+	return MsgMintToken{
+		Owner:  owner,
+		To:     to,
+		Amount: amount,
+	}
+}
+
+func (msg MsgMintToken) Route() string { return RouterKey }
+
+func (msg MsgMintToken) Type() string { return TypeMsgMintToken }
+
+func (msg MsgMintToken) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing owner address")
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing recipient address")
+	}
+	return validateTokenAmount(msg.Amount)
+}
+
+func (msg MsgMintToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgMintToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgBurnToken burns Amount of an issued token from the account From
+type MsgBurnToken struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgBurnToken(from sdk.AccAddress, amount sdk.Coin) MsgBurnToken {
+	return MsgBurnToken{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+func (msg MsgBurnToken) Route() string { return RouterKey }
+
+func (msg MsgBurnToken) Type() string { return TypeMsgBurnToken }
+
+func (msg MsgBurnToken) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	return validateTokenAmount(msg.Amount)
+}
+
+func (msg MsgBurnToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgBurnToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func validateTokenAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "msg amount is invalid: %s", amount)
+	}
+	if amount.Denom == sdk.NativeTokenName {
+		return sdkerrors.Wrapf(ErrInvalidToken, "the native token %s is not managed by the %s module", sdk.NativeTokenName, ModuleName)
+	}
+	return nil
+}
@@ -15,31 +15,70 @@ const (
 	DefaultParamspace        = keeper.DefaultParamspace
 	EventTypeContractCreated = types.EventTypeContractCreated
 	AttributeKeyAddress      = types.AttributeKeyAddress
+	ForkIstanbul             = types.ForkIstanbul
+	ForkBerlin               = types.ForkBerlin
+	ForkCancun               = types.ForkCancun
+	ReceiptStatusFailed      = types.ReceiptStatusFailed
+	ReceiptStatusSuccessful  = types.ReceiptStatusSuccessful
 )
 
 type (
-	Keeper        = keeper.Keeper
-	AccountKeeper = types.AccountKeeper
-	MsgContract   = types.MsgContract
-	CommitStateDB = types.CommitStateDB
-	Log           = types.Log
-	Params        = types.Params
+	Keeper                 = keeper.Keeper
+	AccountKeeper          = types.AccountKeeper
+	MsgContract            = types.MsgContract
+	MsgSetContractMeta     = types.MsgSetContractMeta
+	MsgAddDeployer         = types.MsgAddDeployer
+	MsgRemoveDeployer      = types.MsgRemoveDeployer
+	MsgContractMigrate     = types.MsgContractMigrate
+	MsgUpdateContractAdmin = types.MsgUpdateContractAdmin
+	MsgDepositRent         = types.MsgDepositRent
+	ContractMeta           = types.ContractMeta
+	ContractRent           = types.ContractRent
+	EthTx                  = types.EthTx
+	SignedNonces           = types.SignedNonces
+	CommitStateDB          = types.CommitStateDB
+	Log                    = types.Log
+	Receipt                = types.Receipt
+	Params                 = types.Params
+	AccessList             = types.AccessList
+	AccessTuple            = types.AccessTuple
+	Fork                   = types.Fork
 
 	GenesisState = types.GenesisState
 )
 
 var (
 	// functions aliases
-	NewKeeper        = keeper.NewKeeper
-	NewCommitStateDB = types.NewCommitStateDB
-	NewMsgContract   = types.NewMsgContract
-	NewParams        = types.NewParams
-	DefaultParams    = types.DefaultParams
+	NewKeeper                 = keeper.NewKeeper
+	NewCommitStateDB          = types.NewCommitStateDB
+	NewMsgContract            = types.NewMsgContract
+	NewMsgSetContractMeta     = types.NewMsgSetContractMeta
+	NewMsgAddDeployer         = types.NewMsgAddDeployer
+	NewMsgRemoveDeployer      = types.NewMsgRemoveDeployer
+	NewMsgContractMigrate     = types.NewMsgContractMigrate
+	NewMsgUpdateContractAdmin = types.NewMsgUpdateContractAdmin
+	NewMsgDepositRent         = types.NewMsgDepositRent
+	NewContractMeta           = types.NewContractMeta
+	NewEthTx                  = types.NewEthTx
+	DecodeEthTx               = types.DecodeEthTx
+	EthTxHash                 = types.EthTxHash
+	NewTxDecoder              = types.NewTxDecoder
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	WithGasPrice              = types.WithGasPrice
+	GetGasPrice               = types.GetGasPrice
+	WithEthTxNonce            = types.WithEthTxNonce
+	GetEthTxNonce             = types.GetEthTxNonce
+	WithSignedNonces          = types.WithSignedNonces
+	TakeSignedNonce           = types.TakeSignedNonce
+	GetTxHash                 = types.GetTxHash
 
-	CreateAddress  = common.CreateAddress
-	CreateAddress2 = common.CreateAddress2
-	GenPayload     = cli.GenPayload
-	CodeFromFile   = cli.CodeFromFile
+	CreateAddress     = common.CreateAddress
+	CreateAddress2    = common.CreateAddress2
+	CreateEthAddress  = common.CreateEthAddress
+	CreateEthAddress2 = common.CreateEthAddress2
+	GenPayload        = cli.GenPayload
+	CodeFromFile      = cli.CodeFromFile
 
 	ValidateGenesis = types.ValidateGenesis
 
@@ -60,6 +99,22 @@ var (
 	ErrGasUintOverflow          = types.ErrGasUintOverflow
 	ErrNoPayload                = types.ErrNoPayload
 	ErrWrongCtx                 = types.ErrWrongCtx
+	ErrInvalidEthTx             = types.ErrInvalidEthTx
+	ErrInvalidChainID           = types.ErrInvalidChainID
+	ErrNoReceipt                = types.ErrNoReceipt
+	ErrInvalidOpcode            = types.ErrInvalidOpcode
+	ErrStackUnderflow           = types.ErrStackUnderflow
+	ErrStackOverflow            = types.ErrStackOverflow
+	ErrInvalidABI               = types.ErrInvalidABI
+	ErrNoContractMeta           = types.ErrNoContractMeta
+	ErrNotContractDeployer      = types.ErrNotContractDeployer
+	ErrGasPriceTooLow           = types.ErrGasPriceTooLow
+	ErrDeploymentNotAllowed     = types.ErrDeploymentNotAllowed
+	ErrNotProfiler              = types.ErrNotProfiler
+	ErrNotContractAdmin         = types.ErrNotContractAdmin
+	ErrContractFrozen           = types.ErrContractFrozen
+	ErrContractHalted           = types.ErrContractHalted
+	ErrAccessListNotSupported   = types.ErrAccessListNotSupported
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
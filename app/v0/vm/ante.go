@@ -0,0 +1,193 @@
+package vm
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/ante"
+	authtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+var (
+	_ ante.GasTx = (*types.EthTx)(nil)
+	_ ante.FeeTx = (*types.EthTx)(nil)
+)
+
+// NewAnteHandler returns an AnteHandler that runs Ethereum transactions through
+// the Ethereum ante chain and every other transaction through stdAnteHandler. The
+// gas price of the transactions carrying vm msgs is checked against the vm params first.
+func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, k Keeper, stdAnteHandler sdk.AnteHandler) sdk.AnteHandler {
+	ethAnteHandler := sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewFeePreprocessDecorator(ak),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		NewEthSigVerificationDecorator(ak),
+		ante.NewDeductFeeDecorator(ak, supplyKeeper),
+		NewEthIncrementNonceDecorator(ak), // innermost AnteDecorator
+	)
+
+	mgpd := NewMinGasPriceDecorator(k)
+	snd := NewSignedNoncesDecorator(ak)
+	stdAnteHandlerWithNonces := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return snd.AnteHandle(ctx, tx, simulate, stdAnteHandler)
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if _, ok := tx.(types.EthTx); ok {
+			return mgpd.AnteHandle(ctx, tx, simulate, ethAnteHandler)
+		}
+		return mgpd.AnteHandle(ctx, tx, simulate, stdAnteHandlerWithNonces)
+	}
+}
+
+// SignedNoncesDecorator sets the sequences the signers of a tx carrying vm msgs signed it
+// with on the context, before the ante chain increases them. The vm derives the address of
+// the contract created by the tx from them, see StateTransition
+type SignedNoncesDecorator struct {
+	ak auth.AccountKeeper
+}
+
+func NewSignedNoncesDecorator(ak auth.AccountKeeper) SignedNoncesDecorator {
+	return SignedNoncesDecorator{
+		ak: ak,
+	}
+}
+
+func (snd SignedNoncesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !hasContractMsg(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	nonces := make(types.SignedNonces)
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if _, ok := nonces[signer.String()]; ok {
+				continue
+			}
+			// the ante chain rejects the txs of unknown signers
+			if acc := snd.ak.GetAccount(ctx, signer); acc != nil {
+				nonces[signer.String()] = acc.GetSequence()
+			}
+		}
+	}
+
+	return next(types.WithSignedNonces(ctx, nonces), tx, simulate)
+}
+
+// MinGasPriceDecorator rejects the txs carrying vm msgs whose gas price, the fee in
+// the native token divided by the gas wanted, is under the min gas price of the vm
+// params. The gas price is set on the context for the vm to run the msgs with.
+type MinGasPriceDecorator struct {
+	k Keeper
+}
+
+func NewMinGasPriceDecorator(k Keeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		k: k,
+	}
+}
+
+func (mgpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok || !hasContractMsg(tx) || feeTx.GetGas() == 0 {
+		// a tx without gas is rejected by the ante chain
+		return next(ctx, tx, simulate)
+	}
+
+	gasPrice := feeTx.GetFee().AmountOf(sdk.NativeTokenName).Quo(sdk.NewInt(int64(feeTx.GetGas())))
+
+	if !simulate && ctx.BlockHeight() != 0 {
+		minGasPrice := sdk.NewInt(int64(mgpd.k.GetMinGasPrice(ctx)))
+		if gasPrice.LT(minGasPrice) {
+			return ctx, sdkerrors.Wrapf(types.ErrGasPriceTooLow, "current gasPrice: %s, minGasPrice: %s", gasPrice.String(), minGasPrice.String())
+		}
+	}
+
+	return next(types.WithGasPrice(ctx, gasPrice), tx, simulate)
+}
+
+func hasContractMsg(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(types.MsgContract); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// EthSigVerificationDecorator checks the chain id the sender signed for and the
+// account nonce and consumes the signature verification gas. The recovered pubkey is
+// not set on the sender account: its address is derived from the keccak256 hash of the
+// pubkey, not the one of the pubkey, and each tx carries the signature it is recovered from
+type EthSigVerificationDecorator struct {
+	ak auth.AccountKeeper
+}
+
+func NewEthSigVerificationDecorator(ak auth.AccountKeeper) EthSigVerificationDecorator {
+	return EthSigVerificationDecorator{
+		ak: ak,
+	}
+}
+
+func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ethTx, ok := tx.(types.EthTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be an EthTx")
+	}
+
+	params := esvd.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+
+	chainID := types.EthChainID(ctx.ChainID())
+	if ethTx.GetChainID().Cmp(chainID) != 0 {
+		return ctx, sdkerrors.Wrapf(types.ErrInvalidChainID, "expected %s, got %s", chainID, ethTx.GetChainID())
+	}
+
+	acc, err := ante.GetSignerAcc(ctx, esvd.ak, ethTx.FeePayer())
+	if err != nil {
+		return ctx, err
+	}
+
+	if ethTx.GetNonce() != acc.GetSequence() {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidSequence, "expected %d, got %d", acc.GetSequence(), ethTx.GetNonce())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthIncrementNonceDecorator increments the sequence of the sender, following the
+// same rules as the IncrementSequenceDecorator
+type EthIncrementNonceDecorator struct {
+	ak auth.AccountKeeper
+}
+
+func NewEthIncrementNonceDecorator(ak auth.AccountKeeper) EthIncrementNonceDecorator {
+	return EthIncrementNonceDecorator{
+		ak: ak,
+	}
+}
+
+func (eind EthIncrementNonceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// no need to increment sequence on CheckTx or RecheckTx
+	if ctx.IsCheckTx() && !simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	ethTx, ok := tx.(types.EthTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be an EthTx")
+	}
+
+	acc := eind.ak.GetAccount(ctx, ethTx.FeePayer())
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		panic(err)
+	}
+	eind.ak.SetAccount(ctx, acc)
+
+	// a contract creation consumes the nonce again in the vm, see StateTransition
+	ctx = types.WithSignedNonces(ctx, types.SignedNonces{ethTx.FeePayer().String(): ethTx.GetNonce()})
+	return next(types.WithEthTxNonce(ctx, ethTx.GetNonce()), tx, simulate)
+}
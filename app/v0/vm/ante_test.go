@@ -0,0 +1,183 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/ante"
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian"
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestEthTxAnteHandler(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, supplyKeeper := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeight(1)
+	accountKeeper.SetParams(ctx, auth.DefaultParams())
+
+	stdAnteCalled := false
+	anteHandler := NewAnteHandler(accountKeeper, supplyKeeper, vmKeeper, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		stdAnteCalled = true
+		return ctx, nil
+	})
+
+	priv, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := types.EthChainID(ctx.ChainID())
+
+	newTx := func(nonce uint64, chainID *big.Int) types.EthTx {
+		tx := types.NewEthTx(nonce, keep.Addrs[0], big.NewInt(0), 100000, big.NewInt(6000000), []byte("payload"))
+		require.NoError(t, tx.Sign(chainID, priv))
+		return tx
+	}
+
+	tx := newTx(0, chainID)
+	from := tx.FeePayer()
+	acc := accountKeeper.NewAccountWithAddress(ctx, from)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 1000000000000))))
+	accountKeeper.SetAccount(ctx, acc)
+
+	// wrong chain id
+	_, err = anteHandler(ctx, newTx(0, big.NewInt(1)), false)
+	require.Error(t, err)
+
+	// wrong nonce
+	_, err = anteHandler(ctx, newTx(1, chainID), false)
+	require.Error(t, err)
+
+	// gas price under the vm min gas price
+	vmKeeper.SetMinGasPrice(ctx, 7000000)
+	_, err = anteHandler(ctx, tx, false)
+	require.True(t, types.ErrGasPriceTooLow.Is(err))
+	vmKeeper.SetMinGasPrice(ctx, 6000000)
+
+	newCtx, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.False(t, stdAnteCalled)
+	gasPrice, ok := types.GetGasPrice(newCtx)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(6000000), gasPrice)
+
+	acc = accountKeeper.GetAccount(ctx, from)
+	require.Equal(t, uint64(1), acc.GetSequence())
+	// the address of the secp256k1 pubkey is not the one of the sender, none is stored
+	require.False(t, sdk.AccAddress(tx.GetPubKey().Address()).Equals(from))
+	require.Nil(t, acc.GetPubKey())
+	require.Equal(t, sdk.NewInt(400000000000), acc.GetCoins().AmountOf(sdk.NativeTokenName))
+
+	// the receipt of the tx is stored under its Ethereum hash
+	bz, err := tx.Encode()
+	require.NoError(t, err)
+	_, err = NewHandler(vmKeeper)(newCtx.WithTxBytes(bz).WithGasMeter(sdk.NewGasMeter(tx.GetGas())), tx.GetMsgs()[0])
+	require.NoError(t, err)
+	receipt := vmKeeper.GetReceipt(ctx, types.EthTxHash(bz))
+	require.NotNil(t, receipt)
+	require.Equal(t, types.EthTxHash(bz), receipt.TxHash)
+	require.Nil(t, vmKeeper.GetReceipt(ctx, sdk.BytesToHash(tmhash.Sum(bz))))
+
+	// replay
+	_, err = anteHandler(ctx, tx, false)
+	require.Error(t, err)
+
+	_, err = anteHandler(ctx, auth.StdTx{}, false)
+	require.NoError(t, err)
+	require.True(t, stdAnteCalled)
+}
+
+func TestMinGasPriceDecoratorLegacyParams(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInputWithLegacyParams(t, false, 1000)
+	ctx = ctx.WithBlockHeight(1)
+
+	mgpd := NewMinGasPriceDecorator(vmKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	msg := types.NewMsgContract(keep.Addrs[0], keep.Addrs[1], []byte("payload"), sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+	fee := auth.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 100000)))
+	tx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "")
+
+	// a store without the min gas price falls back to the default
+	require.Equal(t, uint64(types.DefaultMinGasPrice), vmKeeper.GetMinGasPrice(ctx))
+	_, err := mgpd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+}
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeight(10)
+	guardianKeeper := vmKeeper.GuardianKeeper().(guardian.Keeper)
+
+	cbd := ante.NewCircuitBreakerDecorator(guardianKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	contract := keep.Addrs[1]
+	msg := types.NewMsgContract(keep.Addrs[0], contract, []byte("payload"), sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+
+	_, err := cbd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	for _, target := range []string{ModuleName, ModuleName + "/" + types.TypeMsgContractCall} {
+		guardianKeeper.SetDisabledRoute(ctx, guardian.NewDisabledRoute(target, 10, 12, keep.Addrs[0]))
+		_, err = cbd.AnteHandle(ctx, tx, false, next)
+		require.Error(t, err, target)
+
+		// the target is enabled again at the expiry height
+		_, err = cbd.AnteHandle(ctx.WithBlockHeight(12), tx, false, next)
+		require.NoError(t, err, target)
+		guardianKeeper.DeleteDisabledRoute(ctx, target)
+	}
+
+	// the other routes are not stopped, the vm halts the disabled contracts
+	guardianKeeper.SetDisabledRoute(ctx, guardian.NewDisabledRoute(contract.String(), 10, 12, keep.Addrs[0]))
+	guardianKeeper.SetDisabledRoute(ctx, guardian.NewDisabledRoute("bank", 10, 12, keep.Addrs[0]))
+	_, err = cbd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	// the expired routes are deleted at the end of the block before their expiry height
+	guardian.EndBlocker(ctx, guardianKeeper)
+	require.Len(t, guardianKeeper.GetDisabledRoutes(ctx), 2)
+	guardian.EndBlocker(ctx.WithBlockHeight(11), guardianKeeper)
+	require.Empty(t, guardianKeeper.GetDisabledRoutes(ctx))
+}
+
+func TestGuardianDisableRoute(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeight(10)
+	guardianKeeper := vmKeeper.GuardianKeeper().(guardian.Keeper)
+	handler := guardian.NewHandler(guardianKeeper)
+
+	profiler := keep.Addrs[0]
+	require.NoError(t, guardianKeeper.AddProfiler(ctx, guardian.NewGuardian("genesis", guardian.Genesis, profiler, profiler)))
+	disable := func(ctx sdk.Context, disabledBy sdk.AccAddress, expiryHeight int64) error {
+		_, err := handler(ctx, guardian.NewMsgDisableRoute("bank", expiryHeight, disabledBy))
+		return err
+	}
+	enable := func(enabledBy sdk.AccAddress) error {
+		_, err := handler(ctx, guardian.NewMsgEnableRoute("bank", enabledBy))
+		return err
+	}
+
+	// disabling the route again keeps the height it was disabled at
+	require.NoError(t, disable(ctx, profiler, 10+guardian.MaxDisableBlocks))
+	later := ctx.WithBlockHeight(20)
+	require.Error(t, disable(later, profiler, 20+guardian.MaxDisableBlocks))
+	require.NoError(t, disable(later, profiler, 10+guardian.MaxDisableBlocks))
+	disabledRoute, found := guardianKeeper.GetDisabledRoute(ctx, "bank")
+	require.True(t, found)
+	require.Equal(t, int64(10), disabledRoute.DisabledHeight)
+
+	// the governance takes the route over, the guardians can not enable it nor change its expiry
+	require.NoError(t, disable(later, govtypes.ModuleAddress, 20+2*guardian.MaxDisableBlocks))
+	require.Error(t, disable(later, profiler, 30))
+	require.Error(t, enable(profiler))
+	require.True(t, guardianKeeper.IsRouteDisabled(ctx.WithBlockHeight(20+guardian.MaxDisableBlocks), "bank"))
+	require.NoError(t, enable(govtypes.ModuleAddress))
+	require.False(t, guardianKeeper.IsRouteDisabled(ctx, "bank"))
+}
@@ -7,6 +7,21 @@ const (
 	flagMethod       = "method"
 	flagContractAddr = "contract_addr"
 	flagAbiFile      = "abi_file"
+	flagStoreAbi     = "store_abi"
+	flagMetadataFile = "metadata_file"
+	flagAdmin        = "admin"
 	flagShowCode     = "show_code"
 	flagAll          = "all"
+
+	flagTracer         = "tracer"
+	flagDisableMemory  = "disable-memory"
+	flagDisableStack   = "disable-stack"
+	flagDisableStorage = "disable-storage"
+	flagLimit          = "limit"
+
+	flagFromBlock = "from-block"
+	flagToBlock   = "to-block"
+	flagAddresses = "addresses"
+	flagTopics    = "topics"
+	flagPage      = "page"
 )
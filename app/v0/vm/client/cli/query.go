@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/client/utils"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/client"
 	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
@@ -39,6 +40,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryCode(cdc),
 		GetCmdGetStorage(cdc),
 		GetCmdGetLogs(cdc),
+		GetCmdFilterLogs(cdc),
+		GetCmdGetReceipt(cdc),
+		GetCmdQueryContractMeta(cdc),
+		GetCmdQueryDeployers(cdc),
+		GetCmdQueryCodeHistory(cdc),
+		GetCmdQueryContractRent(cdc),
+		GetCmdTraceTx(cdc),
 		GetCmdQueryCreateFee(cdc),
 		GetCmdQueryCallFee(cdc),
 		GetCmdQueryCall(cdc),
@@ -213,11 +221,233 @@ $ %s query vm logs [txHash]`, version.ClientName)),
 	}
 }
 
+func GetCmdFilterLogs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filter-logs",
+		Short: "Querying logs by block range, contract address and topics",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the logs of a block range emitted by one of the addresses and matching the topics.
+Topic positions are separated by ',', the topics of a position by '|' and any of them matches,
+an empty position matches every topic. A non-positive block means the latest one.
+Example:
+$ %s query vm filter-logs --from-block=100 --to-block=200 --addresses=dip1... --topics='ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef,,<topic2a>|<topic2b>' --page=1 --limit=100`, version.ClientName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var addresses []sdk.AccAddress
+			for _, a := range viper.GetStringSlice(flagAddresses) {
+				addr, err := sdk.AccAddressFromBech32(a)
+				if err != nil {
+					return err
+				}
+				addresses = append(addresses, addr)
+			}
+
+			topics, err := ParseTopics(viper.GetString(flagTopics))
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryFilterLogsParams(viper.GetInt64(flagFromBlock), viper.GetInt64(flagToBlock),
+				addresses, topics, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			logs, _, err := utils.QueryFilterLogs(cliCtx, params)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(types.QueryLogsResult{Logs: logs})
+		},
+	}
+
+	cmd.Flags().Int64(flagFromBlock, 0, "first block of the range, the latest block if not positive")
+	cmd.Flags().Int64(flagToBlock, 0, "last block of the range, the latest block if not positive")
+	cmd.Flags().StringSlice(flagAddresses, nil, "comma separated bech32 contract addresses, any of them matches")
+	cmd.Flags().String(flagTopics, "", "topics by position, positions separated by ',' and alternatives by '|'")
+	cmd.Flags().Int(flagPage, 1, "page of the logs to query")
+	cmd.Flags().Int(flagLimit, 100, "number of logs per page")
+
+	return cmd
+}
+
+func GetCmdGetReceipt(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "receipt [txhash]",
+		Short: "Querying receipt by txHash",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the receipt of a vm tx by txHash, including the status,
+the created contract address, the gas used and the logs.
+Example:
+$ %s query vm receipt [txHash]`, version.ClientName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(
+				fmt.Sprintf("custom/vm/%s/%s", types.QueryReceipt, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.Receipt
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryContractMeta(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-meta [address]",
+		Short: "Querying the deployer, abi and metadata of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the deployer of a contract and the abi and source/compiler metadata stored with it.
+Example:
+$ %s query vm contract-meta [address]`, version.ClientName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			meta, _, err := utils.QueryContractMeta(cliCtx, addr)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(meta)
+		},
+	}
+}
+
+func GetCmdQueryDeployers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deployers",
+		Args:  cobra.NoArgs,
+		Short: "Querying the deployer allow-list",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the accounts allowed to create contracts in the allow_list deployment mode.
+Example:
+$ %s query vm deployers`, version.ClientName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryDeployers)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var deployers types.QueryDeployersResult
+			cdc.MustUnmarshalJSON(bz, &deployers)
+			return cliCtx.PrintOutput(deployers)
+		},
+	}
+}
+
+func GetCmdQueryCodeHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "code-history [address]",
+		Short: "Querying the code history of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the hashes of the codes a contract ran with the heights they were set at.
+Example:
+$ %s query vm code-history [address]`, version.ClientName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/vm/%s/%s", types.QueryCodeHistory, addr.String()))
+			if err != nil {
+				return err
+			}
+
+			var history types.CodeHistory
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+}
+
+func GetCmdQueryContractRent(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-rent [address]",
+		Short: "Querying the storage footprint and rent deposit of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the bytes of storage a contract is charged rent for, its rent deposit and whether it is frozen.
+Example:
+$ %s query vm contract-rent [address]`, version.ClientName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/vm/%s/%s", types.QueryContractRent, addr.String()))
+			if err != nil {
+				return err
+			}
+
+			var rent types.ContractRent
+			cdc.MustUnmarshalJSON(res, &rent)
+			return cliCtx.PrintOutput(rent)
+		},
+	}
+}
+
+func GetCmdTraceTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace [txhash]",
+		Short: "Trace the execution of a vm tx",
+		Long: strings.TrimSpace(fmt.Sprintf(`Replay a committed vm tx on the state of its block and trace its execution.
+The struct logger returns every step of the vm, the call tracer returns the tree of calls and creates.
+Example:
+$ %s query vm trace [txHash] --disable-memory --limit=1000
+$ %s query vm trace [txHash] --tracer=callTracer`, version.ClientName, version.ClientName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			config := types.TraceConfig{
+				Tracer:         viper.GetString(flagTracer),
+				DisableMemory:  viper.GetBool(flagDisableMemory),
+				DisableStack:   viper.GetBool(flagDisableStack),
+				DisableStorage: viper.GetBool(flagDisableStorage),
+				Limit:          viper.GetInt(flagLimit),
+			}
+
+			res, err := utils.QueryTrace(cliCtx, args[0], config)
+			if err != nil {
+				return err
+			}
+
+			var out bytes.Buffer
+			if err := json.Indent(&out, res, "", "  "); err != nil {
+				return err
+			}
+			fmt.Println(out.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTracer, "", fmt.Sprintf("tracer to use, empty for the struct logger or %s", types.CallTracerName))
+	cmd.Flags().Bool(flagDisableMemory, false, "do not capture the memory")
+	cmd.Flags().Bool(flagDisableStack, false, "do not capture the stack")
+	cmd.Flags().Bool(flagDisableStorage, false, "do not capture the storage")
+	cmd.Flags().Int(flagLimit, 0, "maximum number of struct logs, zero means unlimited")
+
+	return cmd
+}
+
 func GetCmdQueryCreateFee(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "feecreate [code_file]",
 		Short: "Querying fee to deploy contract",
-		Long: strings.TrimSpace(fmt.Sprintf(`Querying fee to deploy contract.
+		Long: strings.TrimSpace(fmt.Sprintf(`Querying fee to deploy contract, the gas and the lowest gas price the tx is accepted with.
 Example:
 $ %s query vm feecreate [code_file] [from_accaddr]`, version.ClientName)),
 		Args: cobra.ExactArgs(2),
@@ -252,10 +482,11 @@ func GetCmdQueryCallFee(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "feecall [from] [to] [method] [args] [amount] [abi_file]",
 		Short: "Querying fee to call contract",
-		Long: strings.TrimSpace(fmt.Sprintf(`Querying fee to call contract.
+		Long: strings.TrimSpace(fmt.Sprintf(`Querying fee to call contract, the gas and the lowest gas price the tx is accepted with.
+The abi stored on chain is used when abi_file is omitted.
 Example:
 $ %s query vm feecall dip1mfztsv6eq5rhtaz2l6jjp3yup3q80agsqra9qe dip1rk47h83x4nz4745d63dtnpl8uwsramfgz8snr5 balanceOf 0000000000000000000000000000000000000000000000000000000000000001 0pdip ./demo.abi`, version.ClientName)),
-		Args: cobra.ExactArgs(6),
+		Args: cobra.RangeArgs(5, 6),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -269,7 +500,11 @@ $ %s query vm feecall dip1mfztsv6eq5rhtaz2l6jjp3yup3q80agsqra9qe dip1rk47h83x4nz
 				return err
 			}
 
-			abiObj, err := AbiFromFile(args[5])
+			var abiFile string
+			if len(args) == 6 {
+				abiFile = args[5]
+			}
+			abiObj, err := LoadAbi(cliCtx, abiFile, toAddr)
 			if err != nil {
 				return err
 			}
@@ -320,12 +555,13 @@ $ %s query vm feecall dip1mfztsv6eq5rhtaz2l6jjp3yup3q80agsqra9qe dip1rk47h83x4nz
 
 func GetCmdQueryCall(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "call [from] [to] [method] [args] [amount] [abi_file]",
+		Use:   "call [from] [to] [method] [abi_file]",
 		Short: "Querying fee to call contract",
 		Long: strings.TrimSpace(fmt.Sprintf(`call contract for query, don't create a transaction.
+The abi stored on chain for the contract is used when abi_file is omitted.
 Example:
 $ %s query vm call dip1mfztsv6eq5rhtaz2l6jjp3yup3q80agsqra9qe dip1rk47h83x4nz4745d63dtnpl8uwsramfgz8snr5 balanceOf ./demo.abi --amount=0pdip --args="arg1 arg2"`, version.ClientName)),
-		Args: cobra.ExactArgs(4),
+		Args: cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -339,8 +575,17 @@ $ %s query vm call dip1mfztsv6eq5rhtaz2l6jjp3yup3q80agsqra9qe dip1rk47h83x4nz474
 				return err
 			}
 
+			var abiFile string
+			if len(args) == 4 {
+				abiFile = args[3]
+			}
+			abiObj, err := LoadAbi(cliCtx, abiFile, toAddr)
+			if err != nil {
+				return err
+			}
+
 			argList := viper.GetStringSlice(flagArgs)
-			payload, m, err := GenPayload(args[3], args[2], argList)
+			payload, m, err := GenPayloadFromAbi(abiObj, args[2], argList)
 			if err != nil {
 				return err
 			}
@@ -23,6 +23,12 @@ func VMCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		ContractCreateCmd(cdc),
 		ContractCallCmd(cdc),
+		SetContractMetaCmd(cdc),
+		AddDeployerCmd(cdc),
+		RemoveDeployerCmd(cdc),
+		ContractMigrateCmd(cdc),
+		UpdateContractAdminCmd(cdc),
+		DepositRentCmd(cdc),
 	)
 	return txCmd
 }
@@ -31,7 +37,7 @@ func ContractCreateCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "create",
 		Short:   "Create and sign a create contract tx",
-		Example: "dipcli vm create --from=<user key name> --code_file=<code file> --amount=<amount> --args='arg1 arg2 arg3' --abi_file=<abi_file>",
+		Example: "dipcli vm create --from=<user key name> --code_file=<code file> --amount=<amount> --args='arg1 arg2 arg3' --abi_file=<abi_file> [--store_abi] [--metadata_file=<metadata_file>] [--admin=<admin>]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -66,7 +72,32 @@ func ContractCreateCmd(cdc *codec.Codec) *cobra.Command {
 				code = append(code, payload...)
 			}
 
-			msg := types.NewMsgContract(cliCtx.GetFromAddress(), nil, code, coin)
+			var abiJSON string
+			if viper.GetBool(flagStoreAbi) {
+				abiFile := viper.GetString(flagAbiFile)
+				if len(abiFile) == 0 {
+					return fmt.Errorf("must use --abi_file to appoint abi file when use --store_abi")
+				}
+				abiJSON, err = TextFromFile(abiFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			metadata, err := TextFromFile(viper.GetString(flagMetadataFile))
+			if err != nil {
+				return err
+			}
+
+			var admin sdk.AccAddress
+			if adminAddr := viper.GetString(flagAdmin); len(adminAddr) > 0 {
+				admin, err = sdk.AccAddressFromBech32(adminAddr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgContract(cliCtx.GetFromAddress(), nil, code, coin).WithContractMeta(abiJSON, metadata).WithAdmin(admin)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -77,8 +108,11 @@ func ContractCreateCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagCodeFile, "", "contract code file path")
 	cmd.Flags().String(flagAbiFile, "", "contract abi file path")
+	cmd.Flags().Bool(flagStoreAbi, false, "store the abi of --abi_file on chain with the contract")
+	cmd.Flags().String(flagMetadataFile, "", "contract source/compiler metadata file path, stored on chain with the contract")
 	cmd.Flags().String(flagArgs, "", "contract method arg list (e.g. --args='arg1 arg2 arg3')")
 	cmd.Flags().String(flagAmount, "0pdip", "amount of coins to send (e.g. 100pdip)")
+	cmd.Flags().String(flagAdmin, "", "account allowed to migrate the contract, the contract can not be migrated if empty")
 
 	cmd.MarkFlagRequired(flagCodeFile)
 
@@ -91,7 +125,7 @@ func ContractCallCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "call",
 		Short:   "Create and sign a call contract tx",
-		Example: `dipcli vm call --from=<user key name> --contract_addr=<contract_addr> --method=<method> --abi_file=<abi_file>  --args='arg1 arg2 arg3' --amount=<amount> `,
+		Example: `dipcli vm call --from=<user key name> --contract_addr=<contract_addr> --method=<method> [--abi_file=<abi_file>] --args='arg1 arg2 arg3' --amount=<amount> `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -106,15 +140,20 @@ func ContractCallCmd(cdc *codec.Codec) *cobra.Command {
 				coin = coinInput
 			}
 
-			abiFile := viper.GetString(flagAbiFile)
-			method := viper.GetString(flagMethod)
-			argList := viper.GetStringSlice(flagArgs)
-			payload, _, err := GenPayload(abiFile, method, argList)
+			contractAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagContractAddr))
 			if err != nil {
 				return err
 			}
 
-			contractAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagContractAddr))
+			// without an abi file the abi stored on chain for the contract is used
+			abiObj, err := LoadAbi(cliCtx, viper.GetString(flagAbiFile), contractAddr)
+			if err != nil {
+				return err
+			}
+
+			method := viper.GetString(flagMethod)
+			argList := viper.GetStringSlice(flagArgs)
+			payload, _, err := GenPayloadFromAbi(abiObj, method, argList)
 			if err != nil {
 				return err
 			}
@@ -132,11 +171,222 @@ func ContractCallCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagAmount, "0pdip", "amount of coins to send (e.g. 1000000pdip)")
 	cmd.Flags().String(flagMethod, "", "contract method")
 	cmd.Flags().String(flagArgs, "", "contract method arg list")
-	cmd.Flags().String(flagAbiFile, "", "contract abi file path")
+	cmd.Flags().String(flagAbiFile, "", "contract abi file path, the abi stored on chain is used if empty")
 
 	cmd.MarkFlagRequired(flagContractAddr)
 	cmd.MarkFlagRequired(flagMethod)
-	cmd.MarkFlagRequired(flagAbiFile)
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func SetContractMetaCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-abi",
+		Short:   "Create and sign a tx storing the abi and metadata of a contract, only its deployer or admin can send it",
+		Example: `dipcli vm set-abi --from=<deployer key name> --contract_addr=<contract_addr> --abi_file=<abi_file> [--metadata_file=<metadata_file>]`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagContractAddr))
+			if err != nil {
+				return err
+			}
+
+			abiJSON, err := TextFromFile(viper.GetString(flagAbiFile))
+			if err != nil {
+				return err
+			}
+
+			metadata, err := TextFromFile(viper.GetString(flagMetadataFile))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetContractMeta(cliCtx.GetFromAddress(), contractAddr, abiJSON, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagContractAddr, "", "contract bech32 addr")
+	cmd.Flags().String(flagAbiFile, "", "contract abi file path")
+	cmd.Flags().String(flagMetadataFile, "", "contract source/compiler metadata file path")
+
+	cmd.MarkFlagRequired(flagContractAddr)
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func AddDeployerCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-deployer [address]",
+		Short:   "Create and sign a tx adding an account to the deployer allow-list, only a guardian profiler can send it",
+		Example: `dipcli vm add-deployer <address> --from=<profiler key name>`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deployer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddDeployer(cliCtx.GetFromAddress(), deployer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func RemoveDeployerCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-deployer [address]",
+		Short:   "Create and sign a tx removing an account from the deployer allow-list, only a guardian profiler can send it",
+		Example: `dipcli vm remove-deployer <address> --from=<profiler key name>`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deployer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveDeployer(cliCtx.GetFromAddress(), deployer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func ContractMigrateCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate",
+		Short:   "Create and sign a tx replacing the code of a contract, only its admin can send it",
+		Example: `dipcli vm migrate --from=<admin key name> --contract_addr=<contract_addr> --code_file=<runtime code file>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(viper.GetString(flagContractAddr))
+			if err != nil {
+				return err
+			}
+
+			code, err := CodeFromFile(viper.GetString(flagCodeFile))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgContractMigrate(cliCtx.GetFromAddress(), contract, code)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagContractAddr, "", "contract bech32 addr")
+	cmd.Flags().String(flagCodeFile, "", "runtime code file path, the code is not run")
+
+	cmd.MarkFlagRequired(flagContractAddr)
+	cmd.MarkFlagRequired(flagCodeFile)
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func UpdateContractAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-admin [contract] [new admin]",
+		Short:   "Create and sign a tx transferring the admin rights of a contract, or clearing them without a new admin",
+		Example: `dipcli vm update-admin <contract> [<new admin>] --from=<admin key name>`,
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var newAdmin sdk.AccAddress
+			if len(args) > 1 {
+				newAdmin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateContractAdmin(cliCtx.GetFromAddress(), contract, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func DepositRentCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deposit-rent [contract] [amount]",
+		Short:   "Create and sign a tx topping up the deposit the storage rent of a contract is paid from",
+		Example: `dipcli vm deposit-rent <contract> 1000000pdip --from=<user key name>`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositRent(cliCtx.GetFromAddress(), contract, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 
 	cmd = client.PostCommands(cmd)[0]
 
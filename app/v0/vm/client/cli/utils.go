@@ -14,7 +14,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/client/utils"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common/math"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
 	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
@@ -66,13 +68,79 @@ func AbiFromFile(abiFile string) (abiObj abi.ABI, err error) {
 	return abi.JSON(strings.NewReader(string(abiData)))
 }
 
+// LoadAbi reads the abi from abiFile, or when no file is given queries the abi stored
+// on chain for the contract
+func LoadAbi(cliCtx context.CLIContext, abiFile string, contract sdk.AccAddress) (abi.ABI, error) {
+	if len(abiFile) != 0 {
+		return AbiFromFile(abiFile)
+	}
+
+	if contract.Empty() {
+		return abi.ABI{}, errors.New("abi_file can not be empty")
+	}
+	return utils.QueryContractABI(cliCtx, contract)
+}
+
+// TextFromFile returns the trimmed content of the abi or metadata file to store on chain
+func TextFromFile(file string) (string, error) {
+	if len(file) == 0 {
+		return "", nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(data)), nil
+}
+
+// ParseTopics parses the topics of a log filter, positions are separated by ',' and the
+// alternatives of a position by '|', an empty position matches every topic
+func ParseTopics(s string) ([][]sdk.Hash, error) {
+	if len(strings.TrimSpace(s)) == 0 {
+		return nil, nil
+	}
+
+	positions := strings.Split(s, ",")
+	if len(positions) > 4 {
+		return nil, fmt.Errorf("at most 4 topic positions, got %d", len(positions))
+	}
+
+	topics := make([][]sdk.Hash, len(positions))
+	for i, position := range positions {
+		position = strings.TrimSpace(position)
+		if len(position) == 0 {
+			continue
+		}
+
+		for _, t := range strings.Split(position, "|") {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "0x")
+			bz, err := hex.DecodeString(t)
+			if err != nil {
+				return nil, err
+			}
+			if len(bz) != sdk.HashLength {
+				return nil, fmt.Errorf("topic %s must have %d bytes", t, sdk.HashLength)
+			}
+			topics[i] = append(topics[i], sdk.BytesToHash(bz))
+		}
+	}
+
+	return topics, nil
+}
+
 func GenPayload(abiFile, method string, args []string) (payload []byte, m abi.Method, err error) {
-	emptyMethod := abi.Method{}
 	abiObj, err := AbiFromFile(abiFile)
 	if err != nil {
-		return nil, emptyMethod, err
+		return nil, abi.Method{}, err
 	}
 
+	return GenPayloadFromAbi(abiObj, method, args)
+}
+
+func GenPayloadFromAbi(abiObj abi.ABI, method string, args []string) (payload []byte, m abi.Method, err error) {
+	emptyMethod := abi.Method{}
 	if len(method) == 0 { //constructor
 		m = abiObj.Constructor
 	} else if v, ok := abiObj.Methods[method]; ok {
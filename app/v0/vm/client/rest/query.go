@@ -4,8 +4,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/client/utils"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/types/rest"
 	"github.com/gorilla/mux"
 
@@ -33,6 +36,46 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		getLogFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s", types.QueryFilterLogs),
+		filterLogsFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s/{txId}", types.QueryReceipt),
+		getReceiptFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s/{addr}", types.QueryContractMeta),
+		getContractMetaFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s", types.QueryDeployers),
+		getDeployersFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s/{addr}", types.QueryCodeHistory),
+		getCodeHistoryFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s/{addr}", types.QueryContractRent),
+		getContractRentFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/vm/abi/{addr}",
+		getContractABIFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/vm/%s/{txId}", types.QueryTrace),
+		traceTxFn(cliCtx),
+	).Methods("GET")
+
 	// Get the current staking parameter values
 	r.HandleFunc(
 		"/vm/parameters",
@@ -82,12 +125,15 @@ func estimateGas(cliCtx context.CLIContext) http.HandlerFunc {
 
 		d, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		route := fmt.Sprintf("custom/vm/%s", types.EstimateGas)
 		res, height, err := cliCtx.QueryWithData(route, d)
 		if err != nil {
+			// the error carries the revert reason of the contract
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
@@ -141,6 +187,135 @@ func getLog(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func filterLogs(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var params types.QueryFilterLogsParams
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &params) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		logs, height, err := utils.QueryFilterLogs(cliCtx, params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, types.QueryLogsResult{Logs: logs})
+	}
+}
+
+func getReceipt(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		txID := vars["txId"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/vm/%s/%s", types.QueryReceipt, txID)
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getContractMeta(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr := vars["addr"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/vm/%s/%s", types.QueryContractMeta, addr)
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getContractABI serves the ABI stored for a contract as is, so that it can be handed
+// to any ABI aware tool
+func getContractABI(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		contract, err := sdk.AccAddressFromBech32(vars["addr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		meta, _, err := utils.QueryContractMeta(cliCtx, contract)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if len(meta.ABI) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no abi stored for contract %s", contract.String()))
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, []byte(meta.ABI))
+	}
+}
+
+func traceTx(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		txID := vars["txId"]
+
+		query := r.URL.Query()
+		config := types.TraceConfig{
+			Tracer:         query.Get("tracer"),
+			DisableMemory:  query.Get("disableMemory") == "true",
+			DisableStack:   query.Get("disableStack") == "true",
+			DisableStorage: query.Get("disableStorage") == "true",
+		}
+		if limit := query.Get("limit"); limit != "" {
+			l, err := strconv.Atoi(limit)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			config.Limit = l
+		}
+
+		res, err := utils.QueryTrace(cliCtx, txID, config)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func getParams(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -158,6 +333,72 @@ func getParams(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func getDeployers(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/vm/%s", types.QueryDeployers))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getCodeHistory(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/vm/%s/%s", types.QueryCodeHistory, addr.String()))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getContractRent(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["addr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/vm/%s/%s", types.QueryContractRent, addr.String()))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func getStorageFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryStorage(cliCtx)
 }
@@ -174,6 +415,38 @@ func getLogFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return getLog(cliCtx)
 }
 
+func filterLogsFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return filterLogs(cliCtx)
+}
+
+func getReceiptFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getReceipt(cliCtx)
+}
+
+func getContractMetaFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getContractMeta(cliCtx)
+}
+
+func getDeployersFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getDeployers(cliCtx)
+}
+
+func getCodeHistoryFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getCodeHistory(cliCtx)
+}
+
+func getContractRentFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getContractRent(cliCtx)
+}
+
+func getContractABIFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return getContractABI(cliCtx)
+}
+
+func traceTxFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return traceTx(cliCtx)
+}
+
 // HTTP request handler to query the staking params values
 func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return getParams(cliCtx)
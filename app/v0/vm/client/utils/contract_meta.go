@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// QueryContractMeta queries the deployer, ABI and metadata stored for a contract
+func QueryContractMeta(cliCtx context.CLIContext, contract sdk.AccAddress) (meta types.ContractMeta, height int64, err error) {
+	route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryContractMeta, contract.String())
+	res, height, err := cliCtx.Query(route)
+	if err != nil {
+		return meta, height, err
+	}
+
+	err = cliCtx.Codec.UnmarshalJSON(res, &meta)
+	return meta, height, err
+}
+
+// QueryContractABI returns the ABI stored on chain for a contract, for building
+// payloads without a local abi file
+func QueryContractABI(cliCtx context.CLIContext, contract sdk.AccAddress) (abi.ABI, error) {
+	meta, _, err := QueryContractMeta(cliCtx, contract)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	if len(meta.ABI) == 0 {
+		return abi.ABI{}, fmt.Errorf("no abi stored for contract %s", contract.String())
+	}
+
+	return abi.JSON(strings.NewReader(meta.ABI))
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+)
+
+// QueryFilterLogs queries a page of the logs of a block range matching the addresses and topics
+func QueryFilterLogs(cliCtx context.CLIContext, params types.QueryFilterLogsParams) ([]*types.Log, int64, error) {
+	data, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFilterLogs)
+	res, height, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var out types.QueryLogsResult
+	if err := cliCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+		return nil, height, err
+	}
+
+	return out.Logs, height, nil
+}
@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// QueryTrace traces a committed vm tx. It fetches the block of the tx, collects the
+// vm msgs of the txs which come before it in the block and queries the trace at the
+// height before the block so that the vm state the tx ran against is rebuilt.
+func QueryTrace(cliCtx context.CLIContext, txHash string, config types.TraceConfig) ([]byte, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	height, index, err := findTx(cliCtx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if height <= 1 {
+		return nil, fmt.Errorf("cannot trace tx %s of block %d", txHash, height)
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := node.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := resBlock.Block.Txs
+	if int(index) >= len(txs) {
+		return nil, fmt.Errorf("tx %s not found in block %d", txHash, height)
+	}
+
+	decoder := types.NewTxDecoder(cliCtx.Codec)
+	params := types.QueryTraceParams{
+		Header: tmtypes.TM2PB.Header(&resBlock.Block.Header),
+		Config: config,
+	}
+	for i := uint32(0); i < index; i++ {
+		traceTx, err := newTraceTx(decoder, txs[i])
+		if err != nil {
+			// the txs which cannot be decoded have been rejected by the ante handler
+			continue
+		}
+		params.Txs = append(params.Txs, traceTx)
+	}
+
+	params.Tx, err = newTraceTx(decoder, txs[index])
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.WithHeight(height-1).QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTrace), bz)
+	return res, err
+}
+
+// findTx returns the height and the index in its block of a committed tx. An Ethereum tx
+// is known by its Ethereum hash, the block is taken from the receipt stored under it.
+func findTx(cliCtx context.CLIContext, hash []byte) (int64, uint32, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resTx, err := node.Tx(hash, false)
+	if err == nil {
+		return resTx.Height, resTx.Index, nil
+	}
+
+	res, _, qerr := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryReceipt, hex.EncodeToString(hash)))
+	if qerr != nil {
+		// neither a tx nor the receipt of an Ethereum tx, report the tx lookup error
+		return 0, 0, err
+	}
+
+	var receipt types.Receipt
+	if err := cliCtx.Codec.UnmarshalJSON(res, &receipt); err != nil {
+		return 0, 0, err
+	}
+
+	height := int64(receipt.BlockNumber)
+	resBlock, err := node.Block(&height)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for i, tx := range resBlock.Block.Txs {
+		if bytes.Equal(types.EthTxHash(tx).Bytes(), hash) {
+			return height, uint32(i), nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("tx %X not found in block %d", hash, height)
+}
+
+// newTraceTx decodes a tx and keeps its signers and vm msgs
+func newTraceTx(decoder sdk.TxDecoder, txBytes tmtypes.Tx) (types.TraceTx, error) {
+	tx, err := decoder(txBytes)
+	if err != nil {
+		return types.TraceTx{}, err
+	}
+
+	traceTx := types.TraceTx{TxHash: sdk.BytesToHash(txBytes.Hash()), GasPrice: sdk.ZeroInt()}
+
+	if feeTx, ok := tx.(interface {
+		GetGas() uint64
+		GetFee() sdk.Coins
+	}); ok && feeTx.GetGas() > 0 {
+		traceTx.GasPrice = feeTx.GetFee().AmountOf(sdk.NativeTokenName).Quo(sdk.NewInt(int64(feeTx.GetGas())))
+	}
+	if _, ok := tx.(types.EthTx); ok {
+		traceTx.TxHash = types.EthTxHash(txBytes)
+	}
+	seen := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				traceTx.Signers = append(traceTx.Signers, signer)
+			}
+		}
+
+		if msgContract, ok := msg.(types.MsgContract); ok {
+			traceTx.Msgs = append(traceTx.Msgs, msgContract)
+		}
+	}
+
+	return traceTx, nil
+}
@@ -3,6 +3,8 @@ package common
 import (
 	"encoding/binary"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -23,3 +25,14 @@ func CreateAddress2(addr sdk.AccAddress, salt [32]byte, inithash []byte) sdk.Acc
 	bs = append(bs, inithash...)
 	return sdk.BytesToAddress(crypto.Sha256(bs)[12:])
 }
+
+// CreateEthAddress derives the contract address as Ethereum does, keccak256(rlp([addr, nonce]))[12:]
+func CreateEthAddress(addr sdk.AccAddress, nonce uint64) sdk.AccAddress {
+	return sdk.BytesToAddress(ethcrypto.CreateAddress(ethcmn.BytesToAddress(addr), nonce).Bytes())
+}
+
+// CreateEthAddress2 derives the CREATE2 contract address as Ethereum does,
+// keccak256(0xff ++ addr ++ salt ++ inithash)[12:], inithash being the keccak256 hash of the init code
+func CreateEthAddress2(addr sdk.AccAddress, salt [32]byte, inithash []byte) sdk.AccAddress {
+	return sdk.BytesToAddress(ethcrypto.CreateAddress2(ethcmn.BytesToAddress(addr), salt, inithash).Bytes())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -76,3 +77,81 @@ func TestCreateAddress2(t *testing.T) {
 
 	}
 }
+
+func TestCreateEthAddress(t *testing.T) {
+	sender := sdk.BytesToAddress(FromHex("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0"))
+	for nonce, expected := range []string{
+		"0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"0x343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"0xf778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+	} {
+		address := CreateEthAddress(sender, uint64(nonce))
+		if !bytes.Equal(FromHex(expected), address.Bytes()) {
+			t.Errorf("nonce %d: expected %s, got %x", nonce, expected, address.Bytes())
+		}
+	}
+}
+
+func TestCreateEthAddress2(t *testing.T) {
+	type testcase struct {
+		origin   string
+		salt     string
+		code     string
+		expected string
+	}
+
+	// the examples of EIP-1014
+	for i, tt := range []testcase{
+		{
+			origin:   "0x0000000000000000000000000000000000000000",
+			salt:     "0x0000000000000000000000000000000000000000",
+			code:     "0x00",
+			expected: "0x4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38",
+		},
+		{
+			origin:   "0xdeadbeef00000000000000000000000000000000",
+			salt:     "0x0000000000000000000000000000000000000000",
+			code:     "0x00",
+			expected: "0xb928f69bb1d91cd65274e3c79d8986362984fda3",
+		},
+		{
+			origin:   "0xdeadbeef00000000000000000000000000000000",
+			salt:     "0xfeed000000000000000000000000000000000000",
+			code:     "0x00",
+			expected: "0xd04116cdd17bebe565eb2422f2497e06cc1c9833",
+		},
+		{
+			origin:   "0x0000000000000000000000000000000000000000",
+			salt:     "0x0000000000000000000000000000000000000000",
+			code:     "0xdeadbeef",
+			expected: "0x70f2b2914a2a4b783faefb75f459a580616fcb5e",
+		},
+		{
+			origin:   "0x00000000000000000000000000000000deadbeef",
+			salt:     "0xcafebabe",
+			code:     "0xdeadbeef",
+			expected: "0x60f3f640a8508fc6a86d45df051962668e1e8ac7",
+		},
+		{
+			origin:   "0x00000000000000000000000000000000deadbeef",
+			salt:     "0xcafebabe",
+			code:     "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			expected: "0x1d8bfdc5d46dc4f61d6b6115972536ebe6a8854c",
+		},
+		{
+			origin:   "0x0000000000000000000000000000000000000000",
+			salt:     "0x0000000000000000000000000000000000000000",
+			code:     "0x",
+			expected: "0xe33c0c7f7df4809055c3eba6c09cfe4baf1bd9e0",
+		},
+	} {
+		origin := sdk.BytesToAddress(FromHex(tt.origin))
+		salt := sdk.BytesToHash(FromHex(tt.salt))
+		codeHash := ethcrypto.Keccak256(FromHex(tt.code))
+		address := CreateEthAddress2(origin, salt, codeHash)
+
+		if !bytes.Equal(FromHex(tt.expected), address.Bytes()) {
+			t.Errorf("test %d: expected %s, got %x", i, tt.expected, address.Bytes())
+		}
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"golang.org/x/crypto/ripemd160"
 
 	btcsecp256k1 "github.com/btcsuite/btcd/btcec"
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/blake2b"
 	"github.com/ethereum/go-ethereum/crypto/bn256"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common/math"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -27,7 +29,8 @@ type PrecompiledContract interface {
 	Run(input []byte) ([]byte, error) // Run runs the precompiled contract
 }
 
-// PrecompiledContracts contains the default set of pre-compiled contracts used in the Istanbul release.
+// PrecompiledContracts contains the default set of pre-compiled contracts used in the Istanbul release,
+// the contracts a chain runs are registered in the precompiles param of the vm
 var PrecompiledContracts = map[string]PrecompiledContract{
 	(sdk.BytesToAddress([]byte{1})).String(): &ecrecover{},
 	(sdk.BytesToAddress([]byte{2})).String(): &sha256hash{},
@@ -40,6 +43,112 @@ var PrecompiledContracts = map[string]PrecompiledContract{
 	(sdk.BytesToAddress([]byte{9})).String(): &blake2F{},
 }
 
+// StatefulPrecompiledContract is the interface for native Go contracts which read and
+// write the state of the chain, they run within the call frame they are called in.
+type StatefulPrecompiledContract interface {
+	RequiredGas(input []byte) uint64                                               // RequiredGas calculates the contract gas use
+	Run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) // Run runs the precompiled contract
+}
+
+// PrecompileResolver returns the precompiled contract deployed at an address, nil if there is none
+type PrecompileResolver func(addr sdk.AccAddress) StatefulPrecompiledContract
+
+// statelessContract runs a stateless precompiled contract as a stateful one, it runs
+// regardless of the code of the account it is deployed at
+type statelessContract struct {
+	p PrecompiledContract
+}
+
+func (c *statelessContract) RequiredGas(input []byte) uint64 {
+	return c.p.RequiredGas(input)
+}
+
+func (c *statelessContract) Run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
+	return c.p.Run(input)
+}
+
+// StakingContractAddress and DistributionContractAddress are the addresses the stateful
+// precompiled contracts which stake and withdraw rewards on behalf of their caller are
+// registered at by default
+var (
+	StakingContractAddress      = types.PrecompileAddress(1, 0)
+	DistributionContractAddress = types.PrecompileAddress(1, 1)
+)
+
+// precompileImplementation instantiates the contract of an implementation id, nil if the
+// keepers it depends on are missing
+type precompileImplementation func(k Keeper, ctx sdk.Context) StatefulPrecompiledContract
+
+func stateless(p PrecompiledContract) precompileImplementation {
+	return func(Keeper, sdk.Context) StatefulPrecompiledContract {
+		return &statelessContract{p: p}
+	}
+}
+
+// precompileImplementations are the contracts the registry entries refer to by implementation id
+var precompileImplementations = map[string]precompileImplementation{
+	types.PrecompileEcrecover:      stateless(&ecrecover{}),
+	types.PrecompileSha256:         stateless(&sha256hash{}),
+	types.PrecompileRipemd160:      stateless(&ripemd160hash{}),
+	types.PrecompileIdentity:       stateless(&dataCopy{}),
+	types.PrecompileModExp:         stateless(&bigModExp{}),
+	types.PrecompileBn256Add:       stateless(&bn256Add{}),
+	types.PrecompileBn256ScalarMul: stateless(&bn256ScalarMul{}),
+	types.PrecompileBn256Pairing:   stateless(&bn256Pairing{}),
+	types.PrecompileBlake2F:        stateless(&blake2F{}),
+	types.PrecompileStaking: func(k Keeper, ctx sdk.Context) StatefulPrecompiledContract {
+		if k.StakingKeeper() == nil {
+			return nil
+		}
+		return &stakingContract{k: k, gas: k.GetStakingPrecompileGasParams(ctx)}
+	},
+	types.PrecompileDistribution: func(k Keeper, ctx sdk.Context) StatefulPrecompiledContract {
+		if k.DistributionKeeper() == nil {
+			return nil
+		}
+		return &distributionContract{k: k, gas: k.GetStakingPrecompileGasParams(ctx)}
+	},
+}
+
+// newPrecompileResolver resolves the contracts of the precompile registry active at the
+// height of the state and the contracts of the tokens issued through the supply module
+func newPrecompileResolver(k Keeper, stateDB *types.CommitStateDB) PrecompileResolver {
+	ctx := stateDB.Context()
+	precompiles := make(map[ethcmn.Address]StatefulPrecompiledContract)
+	for _, p := range k.GetPrecompiles(ctx) {
+		if !p.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+		if newContract, ok := precompileImplementations[p.Implementation]; ok {
+			if c := newContract(k, ctx); c != nil {
+				precompiles[ethcmn.BytesToAddress(p.Address)] = c
+			}
+		}
+	}
+
+	return func(addr sdk.AccAddress) StatefulPrecompiledContract {
+		// the addresses the call opcodes take from the stack are stripped of leading zeros
+		if p, ok := precompiles[ethcmn.BytesToAddress(addr)]; ok {
+			return p
+		}
+		return resolveTokenContract(k, stateDB, addr)
+	}
+}
+
+// RunStatefulPrecompiledContract runs and evaluates the output of a stateful precompiled contract.
+func RunStatefulPrecompiledContract(evm *EVM, p StatefulPrecompiledContract, input []byte, contract *Contract, readOnly bool) (ret []byte, err error) {
+	gas := p.RequiredGas(input)
+	if contract.UseGas(gas) {
+		return p.Run(evm, contract, input, readOnly)
+	}
+	return nil, ErrOutOfGas
+}
+
+// revertWith makes a stateful precompiled contract revert with reason
+func revertWith(reason string) ([]byte, error) {
+	return types.PackRevertReason(reason), ErrExecutionReverted
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
 	gas := p.RequiredGas(input)
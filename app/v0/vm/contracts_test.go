@@ -2,13 +2,18 @@ package vm
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common"
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
 )
 
 // precompiledTest defines the input/output pairs for precompiled contract tests.
@@ -549,3 +554,39 @@ func TestPrecompiledEcrecover(t *testing.T) {
 		testPrecompiled("01", test, t)
 	}
 }
+
+func TestPrecompileRegistry(t *testing.T) {
+	for _, id := range types.PrecompileImplementations {
+		require.NotNil(t, precompileImplementations[id], id)
+	}
+
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	input := []byte("registry")
+	digest := sha256.Sum256(input)
+	call := func(addr sdk.AccAddress) []byte {
+		msg := types.NewMsgContract(keep.Addrs[0], addr, input, sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+		res, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), msg)
+		require.NoError(t, err)
+		return res.Data
+	}
+
+	sha256Addr := types.PrecompileAddress(2)
+	require.Equal(t, digest[:], call(sha256Addr))
+
+	// a disabled contract leaves an empty account behind
+	precompiles := vmKeeper.GetPrecompiles(ctx)
+	require.Equal(t, types.PrecompileSha256, precompiles[1].Implementation)
+	precompiles[1].Enabled = false
+
+	// an implementation registered at a chain specific address runs from its activation height on
+	otherAddr := types.PrecompileAddress(2, 0)
+	precompiles = append(precompiles, types.NewPrecompile(otherAddr, types.PrecompileSha256, true, ctx.BlockHeight()+1))
+	vmKeeper.SetPrecompiles(ctx, precompiles)
+	require.Empty(t, call(sha256Addr))
+	require.Empty(t, call(otherAddr))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Empty(t, call(sha256Addr))
+	require.Equal(t, digest[:], call(otherAddr))
+}
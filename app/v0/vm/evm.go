@@ -4,8 +4,7 @@ import (
 	"math/big"
 	"time"
 
-	"github.com/tendermint/tendermint/crypto"
-
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -14,6 +13,15 @@ type (
 	CanTransferFunc func(sdk.AccAddress, *big.Int) bool
 	// TransferFunc is the signature of a transfer function
 	TransferFunc func(sdk.AccAddress, sdk.AccAddress, *big.Int)
+	// CanDeployFunc is the signature of a deployment guard function, it is given the
+	// origin of the tx creating a contract
+	CanDeployFunc func(sdk.AccAddress) bool
+	// IsFrozenFunc is the signature of a call guard function, it is given the called
+	// contract
+	IsFrozenFunc func(sdk.AccAddress) bool
+	// IsHaltedFunc is the signature of a call guard function, it is given the called
+	// contract
+	IsHaltedFunc func(sdk.AccAddress) bool
 	// GetHashFunc returns the nth block hash in the blockchain
 	// and is used by the BLOCKHASH EVM op code.
 	//GetHashFunc func(sdk.Context) types.Hash
@@ -22,9 +30,15 @@ type (
 
 func run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		precompiles := PrecompiledContracts
-		if p := precompiles[contract.CodeAddr.String()]; p != nil {
-			return RunPrecompiledContract(p, input, contract)
+		if p := evm.precompile(*contract.CodeAddr); p != nil {
+			// the stateful precompiled contracts are deployed at accounts without code
+			if _, ok := p.(*statelessContract); ok || len(contract.Code) == 0 {
+				// a call made from a static call can not modify the state either
+				if in, ok := evm.interpreter.(*EVMInterpreter); ok && in.readOnly {
+					readOnly = true
+				}
+				return RunStatefulPrecompiledContract(evm, p, input, contract, readOnly)
+			}
 		}
 	}
 	for _, interpreter := range evm.interpreters {
@@ -50,9 +64,6 @@ type codeAndHash struct {
 }
 
 func (c *codeAndHash) Hash() sdk.Hash {
-	if c.hash == (sdk.Hash{}) {
-		copy(c.hash[:], crypto.Sha256(c.code))
-	}
 	return c.hash
 }
 
@@ -76,6 +87,7 @@ type Context struct {
 	GasLimit    uint64
 	BlockNumber *big.Int
 	Time        *big.Int
+	BaseFee     *big.Int // the min gas price, there is no fee market
 }
 
 type EVM struct {
@@ -103,6 +115,10 @@ type EVM struct {
 	// available gas is calculated in gasCall* according to the 63/64 rule and later
 	// applied in opCall*.
 	callGasTemp uint64
+
+	// created holds the contracts created by contracts, the creations reverted later
+	// are left in it
+	created []sdk.AccAddress
 }
 
 func NewEVM(ctx Context, statedb *CommitStateDB, vmConfig Config) *EVM {
@@ -118,15 +134,74 @@ func NewEVM(ctx Context, statedb *CommitStateDB, vmConfig Config) *EVM {
 	return evm
 }
 
+// precompile returns the precompiled contract deployed at addr, nil if there is none. An evm
+// without precompile registry runs the default set of stateless contracts
+func (evm *EVM) precompile(addr sdk.AccAddress) StatefulPrecompiledContract {
+	if evm.vmConfig.Precompiles == nil {
+		if p := PrecompiledContracts[addr.String()]; p != nil {
+			return &statelessContract{p: p}
+		}
+		return nil
+	}
+	return evm.vmConfig.Precompiles(addr)
+}
+
 // Interpreter returns the current interpreter
+// isFrozen returns whether the code at addr can not be run as the storage rent deposit of
+// the contract ran out
+func (evm *EVM) isFrozen(addr sdk.AccAddress) bool {
+	return evm.vmConfig.IsFrozen != nil && evm.vmConfig.IsFrozen(addr)
+}
+
+// isHalted returns whether the code at addr can not be run as the guardians disabled the
+// calls of the contract
+func (evm *EVM) isHalted(addr sdk.AccAddress) bool {
+	return evm.vmConfig.IsHalted != nil && evm.vmConfig.IsHalted(addr)
+}
+
 func (evm *EVM) Interpreter() Interpreter {
 	return evm.interpreter
 }
 
 // Create creates a new contract using code as deployment code
 func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, value *big.Int) (ret []byte, contractAddr sdk.AccAddress, leftOverGas uint64, err error) {
-	contractAddr = CreateAddress(caller.Address(), evm.StateDB.GetNonce(caller.Address()))
-	return evm.create(caller, &codeAndHash{code: code}, gas, value, contractAddr)
+	contractAddr = evm.createAddress(caller.Address(), evm.StateDB.GetNonce(caller.Address()))
+	return evm.create(caller, evm.newCodeAndHash(code), gas, value, contractAddr)
+}
+
+// increaseCallerNonce increases the nonce of a contract calling or creating another one.
+// Ethereum only counts the creations, of every creator, in the nonce
+func (evm *EVM) increaseCallerNonce(caller sdk.AccAddress, create bool) {
+	if evm.StateDB.EthCompatible() {
+		if create {
+			evm.StateDB.SetNonce(caller, evm.StateDB.GetNonce(caller)+1)
+		}
+		return
+	}
+
+	if evm.StateDB.GetCodeHash(caller) != (sdk.Hash{}) {
+		evm.StateDB.SetNonce(caller, evm.StateDB.GetNonce(caller)+1)
+	}
+}
+
+func (evm *EVM) newCodeAndHash(code []byte) *codeAndHash {
+	return &codeAndHash{code: code, hash: evm.StateDB.HashCode(code)}
+}
+
+// createAddress derives the address of a contract created by CREATE
+func (evm *EVM) createAddress(caller sdk.AccAddress, nonce uint64) sdk.AccAddress {
+	if evm.StateDB.EthCompatible() {
+		return CreateEthAddress(caller, nonce)
+	}
+	return CreateAddress(caller, nonce)
+}
+
+// createAddress2 derives the address of a contract created by CREATE2
+func (evm *EVM) createAddress2(caller sdk.AccAddress, salt [32]byte, inithash []byte) sdk.AccAddress {
+	if evm.StateDB.EthCompatible() {
+		return CreateEthAddress2(caller, salt, inithash)
+	}
+	return CreateAddress2(caller, salt, inithash)
 }
 
 func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64, value *big.Int, address sdk.AccAddress) ([]byte, sdk.AccAddress, uint64, error) {
@@ -139,11 +214,17 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 		return nil, sdk.AccAddress{}, gas, ErrInsufficientBalance
 	}
 
-	// increase contract account nonce
-	callerCodeHash := evm.StateDB.GetCodeHash(caller.Address())
-	if callerCodeHash != (sdk.Hash{}) {
-		nonce := evm.StateDB.GetNonce(caller.Address())
-		evm.StateDB.SetNonce(caller.Address(), nonce+1)
+	// the contracts created by contracts are subject to the deployment mode too, a
+	// factory contract can not deploy on behalf of a tx sender who may not deploy
+	if evm.vmConfig.CanDeploy != nil && !evm.vmConfig.CanDeploy(evm.Origin) {
+		return nil, sdk.AccAddress{}, gas, ErrDeploymentNotAllowed
+	}
+
+	evm.increaseCallerNonce(caller.Address(), true)
+
+	// the created account is warm even if the creation fails, see EIP 2929
+	if evm.vmConfig.Fork >= types.ForkBerlin {
+		evm.StateDB.AddAddressToAccessList(address)
 	}
 
 	// Ensure there's no existing contract already at the designated address
@@ -167,6 +248,10 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 		return nil, address, gas, nil
 	}
 
+	if evm.vmConfig.Debug && evm.depth == 0 {
+		evm.vmConfig.Tracer.CaptureStart(caller.Address(), address, true, codeAndHash.code, gas, value)
+	}
+
 	start := time.Now()
 	ret, err := run(evm, contract, nil, false)
 	maxCodeSizeExceeded := len(ret) > int(evm.vmConfig.MaxCodeSize)
@@ -199,6 +284,9 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 
 	if err == nil {
 		evm.StateDB.ContractCreatedEvent(address)
+		if evm.depth > 0 {
+			evm.created = append(evm.created, address)
+		}
 	}
 
 	return ret, address, contract.Gas, err
@@ -209,8 +297,8 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 // The different between Create2 with Create is Create2 uses sha3(0xff ++ msg.sender ++ salt ++ sha3(init_code))[12:]
 // instead of the usual sender-and-nonce-hash as the address where the contract is initialized at.
 func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *big.Int, salt *big.Int) (ret []byte, contractAddr sdk.AccAddress, leftOverGas uint64, err error) {
-	codeAndHash := &codeAndHash{code: code}
-	contractAddr = CreateAddress2(caller.Address(), sdk.BigToHash(salt), codeAndHash.Hash().Bytes())
+	codeAndHash := evm.newCodeAndHash(code)
+	contractAddr = evm.createAddress2(caller.Address(), sdk.BigToHash(salt), codeAndHash.Hash().Bytes())
 	return evm.create(caller, codeAndHash, gas, endowment, contractAddr)
 }
 
@@ -223,15 +311,19 @@ func (evm *EVM) Call(caller ContractRef, addr sdk.AccAddress, input []byte, gas
 		return nil, gas, ErrDepth
 	}
 
+	if evm.isFrozen(addr) {
+		return nil, gas, ErrContractFrozen
+	}
+
+	if evm.isHalted(addr) {
+		return nil, gas, ErrContractHalted
+	}
+
 	if !evm.Context.CanTransfer(caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
 	}
 
-	callerCodeHash := evm.StateDB.GetCodeHash(caller.Address())
-	if callerCodeHash != (sdk.Hash{}) {
-		nonce := evm.StateDB.GetNonce(caller.Address())
-		evm.StateDB.SetNonce(caller.Address(), nonce+1)
-	}
+	evm.increaseCallerNonce(caller.Address(), false)
 
 	var (
 		to       = AccountRef(addr)
@@ -239,9 +331,7 @@ func (evm *EVM) Call(caller ContractRef, addr sdk.AccAddress, input []byte, gas
 	)
 
 	if !evm.StateDB.Exist(addr) {
-		precompiles := PrecompiledContracts
-
-		if precompiles[addr.String()] == nil && value.Sign() == 0 {
+		if evm.precompile(addr) == nil && value.Sign() == 0 {
 
 			if evm.vmConfig.Debug && evm.depth == 0 {
 				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
@@ -293,15 +383,19 @@ func (evm *EVM) CallCode(caller ContractRef, addr sdk.AccAddress, input []byte,
 		return nil, gas, ErrDepth
 	}
 
+	if evm.isFrozen(addr) {
+		return nil, gas, ErrContractFrozen
+	}
+
+	if evm.isHalted(addr) {
+		return nil, gas, ErrContractHalted
+	}
+
 	if !evm.CanTransfer(caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
 	}
 
-	callerCodeHash := evm.StateDB.GetCodeHash(caller.Address())
-	if callerCodeHash != (sdk.Hash{}) {
-		nonce := evm.StateDB.GetNonce(caller.Address())
-		evm.StateDB.SetNonce(caller.Address(), nonce+1)
-	}
+	evm.increaseCallerNonce(caller.Address(), false)
 
 	var (
 		to       = AccountRef(caller.Address())
@@ -337,12 +431,16 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr sdk.AccAddress, input []by
 		return nil, gas, ErrDepth
 	}
 
-	callerCodeHash := evm.StateDB.GetCodeHash(caller.Address())
-	if callerCodeHash != (sdk.Hash{}) {
-		nonce := evm.StateDB.GetNonce(caller.Address())
-		evm.StateDB.SetNonce(caller.Address(), nonce+1)
+	if evm.isFrozen(addr) {
+		return nil, gas, ErrContractFrozen
 	}
 
+	if evm.isHalted(addr) {
+		return nil, gas, ErrContractHalted
+	}
+
+	evm.increaseCallerNonce(caller.Address(), false)
+
 	var (
 		to       = AccountRef(caller.Address())
 		snapshot = evm.StateDB.Snapshot()
@@ -375,12 +473,16 @@ func (evm *EVM) StaticCall(caller ContractRef, addr sdk.AccAddress, input []byte
 		return nil, gas, ErrDepth
 	}
 
-	callerCodeHash := evm.StateDB.GetCodeHash(caller.Address())
-	if callerCodeHash != (sdk.Hash{}) {
-		nonce := evm.StateDB.GetNonce(caller.Address())
-		evm.StateDB.SetNonce(caller.Address(), nonce+1)
+	if evm.isFrozen(addr) {
+		return nil, gas, ErrContractFrozen
 	}
 
+	if evm.isHalted(addr) {
+		return nil, gas, ErrContractHalted
+	}
+
+	evm.increaseCallerNonce(caller.Address(), false)
+
 	var (
 		to       = AccountRef(addr)
 		snapshot = evm.StateDB.Snapshot()
@@ -73,13 +73,14 @@ var (
 	gasCodeCopy       = memoryCopierGas(2)
 	gasExtCodeCopy    = memoryCopierGas(3)
 	gasReturnDataCopy = memoryCopierGas(2)
+	gasMcopy          = memoryCopierGas(2)
 )
 
 // gasSStoreEIP2200
 func gasSStore(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
 	// If we fail the minimum gas availability invariant, fail (0)
 	if contract.Gas <= SstoreSentryGas {
-		return 0, sdkerrors.Wrap(sdkerrors.ErrInternal, "not enough gas for reentrancy sentry")
+		return 0, sdkerrors.Wrap(ErrOutOfGas, "not enough gas for reentrancy sentry")
 	}
 	// Gas sentry honoured, do the actual gas calculation based on the stored value
 	var (
@@ -317,3 +318,139 @@ func gasSelfdestruct(evm *EVM, contract *Contract, stack *Stack, mem *Memory, me
 	}
 	return gas, nil
 }
+
+// gasSLoadEIP2929 charges the rest of the cold cost on the first access to the slot in the tx,
+// the warm cost is the constant gas of SLOAD
+func gasSLoadEIP2929(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	slot := sdk.BigToHash(stack.Back(0))
+	if _, slotPresent := evm.StateDB.SlotInAccessList(contract.Address(), slot); !slotPresent {
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+		return ColdSloadCostEIP2929 - WarmStorageReadCostEIP2929, nil
+	}
+	return 0, nil
+}
+
+// gasSStoreEIP2929 is gasSStore with the costs of EIP 2929, a slot written first in the tx
+// is charged the cold cost on top
+func gasSStoreEIP2929(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	// If we fail the minimum gas availability invariant, fail (0)
+	if contract.Gas <= SstoreSentryGas {
+		return 0, sdkerrors.Wrap(ErrOutOfGas, "not enough gas for reentrancy sentry")
+	}
+	var (
+		y, x    = stack.Back(1), stack.Back(0)
+		slot    = sdk.BigToHash(x)
+		current = evm.StateDB.GetState(contract.Address(), slot)
+		cost    = uint64(0)
+	)
+	if _, slotPresent := evm.StateDB.SlotInAccessList(contract.Address(), slot); !slotPresent {
+		cost = ColdSloadCostEIP2929
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+	}
+	value := sdk.BigToHash(y)
+
+	if current == value { // noop (1)
+		return cost + WarmStorageReadCostEIP2929, nil
+	}
+	original := evm.StateDB.GetCommittedState(contract.Address(), slot)
+	if original == current {
+		if original == (sdk.Hash{}) { // create slot (2.1.1)
+			return cost + SstoreInitGas, nil
+		}
+		if value == (sdk.Hash{}) { // delete slot (2.1.2b)
+			evm.StateDB.AddRefund(SstoreClearRefund)
+		}
+		return cost + (SstoreCleanGas - ColdSloadCostEIP2929), nil // write existing slot (2.1.2)
+	}
+	if original != (sdk.Hash{}) {
+		if current == (sdk.Hash{}) { // recreate slot (2.2.1.1)
+			evm.StateDB.SubRefund(SstoreClearRefund)
+		} else if value == (sdk.Hash{}) { // delete slot (2.2.1.2)
+			evm.StateDB.AddRefund(SstoreClearRefund)
+		}
+	}
+	if original == value {
+		if original == (sdk.Hash{}) { // reset to original inexistent slot (2.2.2.1)
+			evm.StateDB.AddRefund(SstoreInitGas - WarmStorageReadCostEIP2929)
+		} else { // reset to original existing slot (2.2.2.2)
+			evm.StateDB.AddRefund((SstoreCleanGas - ColdSloadCostEIP2929) - WarmStorageReadCostEIP2929)
+		}
+	}
+	return cost + WarmStorageReadCostEIP2929, nil // dirty update (2.2)
+}
+
+// gasAccountCheckEIP2929 charges the rest of the cold cost on the first access to the account
+// on top of the stack in the tx, it prices BALANCE, EXTCODESIZE and EXTCODEHASH
+func gasAccountCheckEIP2929(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	addr := sdk.BigToAddress(stack.Back(0))
+	if !evm.StateDB.AddressInAccessList(addr) {
+		evm.StateDB.AddAddressToAccessList(addr)
+		return ColdAccountAccessCostEIP2929 - WarmStorageReadCostEIP2929, nil
+	}
+	return 0, nil
+}
+
+func gasExtCodeCopyEIP2929(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	gas, err := gasExtCodeCopy(evm, contract, stack, mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	addr := sdk.BigToAddress(stack.Back(0))
+	if !evm.StateDB.AddressInAccessList(addr) {
+		evm.StateDB.AddAddressToAccessList(addr)
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, ColdAccountAccessCostEIP2929-WarmStorageReadCostEIP2929); overflow {
+			return 0, ErrGasUintOverflow
+		}
+	}
+	return gas, nil
+}
+
+// makeCallVariantGasCallEIP2929 charges the rest of the cold cost on the first call to the
+// account in the tx. It is charged before the gas of the call is computed, so that the call
+// gets 63/64 of what is left after it
+func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
+	return func(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		addr := sdk.BigToAddress(stack.Back(1))
+		warmAccess := evm.StateDB.AddressInAccessList(addr)
+		coldCost := ColdAccountAccessCostEIP2929 - WarmStorageReadCostEIP2929
+		if !warmAccess {
+			evm.StateDB.AddAddressToAccessList(addr)
+			if !contract.UseGas(coldCost) {
+				return 0, ErrOutOfGas
+			}
+		}
+
+		gas, err := oldCalculator(evm, contract, stack, mem, memorySize)
+		if warmAccess || err != nil {
+			return gas, err
+		}
+		// the cold cost was taken from the contract, it is given back to be charged with the rest
+		contract.Gas += coldCost
+		return gas + coldCost, nil
+	}
+}
+
+var (
+	gasCallEIP2929         = makeCallVariantGasCallEIP2929(gasCall)
+	gasCallCodeEIP2929     = makeCallVariantGasCallEIP2929(gasCallCode)
+	gasDelegateCallEIP2929 = makeCallVariantGasCallEIP2929(gasDelegateCall)
+	gasStaticCallEIP2929   = makeCallVariantGasCallEIP2929(gasStaticCall)
+)
+
+func gasSelfdestructEIP2929(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	gas := SelfdestructGas
+	address := sdk.BigToAddress(stack.Back(0))
+	if !evm.StateDB.AddressInAccessList(address) {
+		evm.StateDB.AddAddressToAccessList(address)
+		gas += ColdAccountAccessCostEIP2929
+	}
+	if evm.StateDB.Empty(address) && evm.StateDB.GetBalance(contract.Address()).Sign() != 0 {
+		gas += CreateBySelfdestructGas
+	}
+
+	if !evm.StateDB.HasSuicided(contract.Address()) {
+		evm.StateDB.AddRefund(SelfdestructRefundGas)
+	}
+	return gas, nil
+}
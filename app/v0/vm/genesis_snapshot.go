@@ -0,0 +1,208 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/genaccounts"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/genutil"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
+	"github.com/Dipper-Labs/Dipper-Protocol/server"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+const flagChunkSize = "chunk-size"
+
+// ImportStateCmd loads a vm state snapshot into genesis.json
+func ImportStateCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-state [snapshot]",
+		Short: "Load the codes, storage and accounts of a vm state snapshot into genesis.json",
+		Long: `Load the codes, storage and accounts of a vm state snapshot into genesis.json. The accounts
+of the snapshot replace the native token balance, the nonce and the code of the genesis
+accounts at the same address. Leave the supply of genesis.json empty to have it summed up
+from the accounts.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			sr, err := types.NewSnapshotReader(f)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			if err := ImportSnapshotToGenesis(cdc, appState, sr); err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState = appStateJSON
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	return cmd
+}
+
+// ImportSnapshotToGenesis adds the records of a snapshot to the vm and the genesis accounts
+// of appState
+func ImportSnapshotToGenesis(cdc *codec.Codec, appState map[string]json.RawMessage, sr *types.SnapshotReader) error {
+	var vmGenesis GenesisState
+	cdc.MustUnmarshalJSON(appState[ModuleName], &vmGenesis)
+	if vmGenesis.Codes == nil {
+		vmGenesis.Codes = make(map[string]sdk.Code)
+	}
+
+	accounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	index := make(map[string]int, len(accounts))
+	for i, acc := range accounts {
+		index[acc.Address.String()] = i
+	}
+
+	for {
+		record, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch r := record.(type) {
+		case types.SnapshotCode:
+			vmGenesis.Codes[hexutil.Encode(r.Hash)] = r.Code
+		case types.SnapshotStorage:
+			vmGenesis.Storage = append(vmGenesis.Storage, types.Storage{
+				Key:   append(r.Address.Bytes(), r.Key.Bytes()...),
+				Value: r.Value.Bytes(),
+				Slot:  r.Slot,
+			})
+		case types.SnapshotAccount:
+			balance := sdk.NewCoin(sdk.NativeTokenName, sdk.NewIntFromBigInt(r.Balance))
+			i, ok := index[r.Address.String()]
+			if !ok {
+				accounts = append(accounts, genaccounts.NewGenesisAccountRaw(r.Address, nil, nil, 0, 0, ""))
+				i = len(accounts) - 1
+				index[r.Address.String()] = i
+			}
+
+			acc := &accounts[i]
+			acc.Coins = acc.Coins.Sub(sdk.NewCoins(sdk.NewCoin(sdk.NativeTokenName, acc.Coins.AmountOf(sdk.NativeTokenName))))
+			if balance.IsPositive() {
+				acc.Coins = acc.Coins.Add(sdk.NewCoins(balance))
+			}
+			acc.Sequence = r.Nonce
+			acc.CodeHash = r.CodeHash
+		}
+	}
+
+	if err := ValidateGenesis(vmGenesis); err != nil {
+		return err
+	}
+
+	appState[ModuleName] = cdc.MustMarshalJSON(vmGenesis)
+	genaccounts.SetGenesisStateInAppState(cdc, appState, accounts)
+	return nil
+}
+
+// SnapshotToGethAllocCmd converts a vm state snapshot into the alloc of a go-ethereum genesis
+func SnapshotToGethAllocCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshot-to-alloc [snapshot] [alloc.json]",
+		Short: "Convert a vm state snapshot into the alloc of a go-ethereum genesis",
+		Long: `Convert a vm state snapshot into the alloc of a go-ethereum genesis, to be loaded by geth or
+Hardhat. The storage slots written before their keys were recorded are left out.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			sr, err := types.NewSnapshotReader(f)
+			if err != nil {
+				return err
+			}
+
+			alloc, skipped, err := types.SnapshotToGethAlloc(sr)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(alloc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if skipped > 0 {
+				fmt.Fprintf(os.Stderr, "%d storage slots left out, their keys were not recorded\n", skipped)
+			}
+			return ioutil.WriteFile(args[1], bz, 0644)
+		},
+	}
+}
+
+// GethAllocToSnapshotCmd converts the alloc of a go-ethereum genesis into a vm state snapshot
+func GethAllocToSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alloc-to-snapshot [genesis.json] [snapshot]",
+		Short: "Convert the alloc of a go-ethereum genesis, or a bare alloc, into a vm state snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			alloc, err := types.ParseGethAlloc(bz)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			sw, err := types.NewSnapshotWriter(f, 0, viper.GetInt(flagChunkSize))
+			if err != nil {
+				return err
+			}
+
+			return types.GethAllocToSnapshot(alloc, sw)
+		},
+	}
+
+	cmd.Flags().Int(flagChunkSize, types.DefaultSnapshotChunkSize, "payload size of the chunks of the snapshot in bytes")
+	return cmd
+}
@@ -14,6 +14,18 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgContract:
 			return handleMsgContract(ctx, msg, k)
+		case MsgSetContractMeta:
+			return handleMsgSetContractMeta(ctx, msg, k)
+		case MsgAddDeployer:
+			return handleMsgAddDeployer(ctx, msg, k)
+		case MsgRemoveDeployer:
+			return handleMsgRemoveDeployer(ctx, msg, k)
+		case MsgContractMigrate:
+			return handleMsgContractMigrate(ctx, msg, k)
+		case MsgUpdateContractAdmin:
+			return handleMsgUpdateContractAdmin(ctx, msg, k)
+		case MsgDepositRent:
+			return handleMsgDepositRent(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -26,9 +38,23 @@ func handleMsgContract(ctx sdk.Context, msg MsgContract, k Keeper) (*sdk.Result,
 		return nil, err
 	}
 
+	if msg.To.Empty() && !k.CanDeploy(ctx, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrDeploymentNotAllowed, "%s in deployment mode %s", msg.From.String(), k.GetDeploymentMode(ctx))
+	}
+
+	// the called contract pays the rent it owes, the contracts it calls are frozen once
+	// their deposit does not cover it
+	if !msg.To.Empty() {
+		k.ChargeStorageRent(ctx, msg.To)
+		if k.IsFrozen(ctx, msg.To) {
+			return nil, sdkerrors.Wrapf(types.ErrContractFrozen, "contract %s", msg.To.String())
+		}
+	}
+
 	_, res, err := DoStateTransition(ctx, msg, k, ctx.Simulate)
 	if err != nil {
-		return &sdk.Result{Data: res.Data, GasUsed: res.GasUsed}, err
+		// the events tell why the vm execution failed
+		return &sdk.Result{Data: res.Data, GasUsed: res.GasUsed, Events: ctx.EventManager().Events()}, err
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -40,3 +66,226 @@ func handleMsgContract(ctx sdk.Context, msg MsgContract, k Keeper) (*sdk.Result,
 
 	return &sdk.Result{Data: res.Data, GasUsed: res.GasUsed, Events: ctx.EventManager().Events()}, nil
 }
+
+// handleMsgSetContractMeta replaces the abi and metadata of a contract, the deployer recorded
+// at creation and the admin are allowed to do so. The contracts created before the metadata
+// was recorded have none, a profiler of the guardian module records it for them
+func handleMsgSetContractMeta(ctx sdk.Context, msg MsgSetContractMeta, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := k.GetContractMeta(ctx, msg.Contract)
+	if meta == nil {
+		if len(k.GetCode(ctx, msg.Contract)) == 0 {
+			return nil, sdkerrors.Wrapf(types.ErrNoContractMeta, "contract %s", msg.Contract.String())
+		}
+		if _, ok := k.GuardianKeeper().GetProfiler(ctx, msg.From); !ok {
+			return nil, sdkerrors.Wrapf(types.ErrNoContractMeta, "contract %s, only a profiler can record it", msg.Contract.String())
+		}
+		newMeta := types.NewContractMeta(msg.From, "", "")
+		meta = &newMeta
+	} else if !meta.Deployer.Equals(msg.From) && !meta.Admin.Equals(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrNotContractDeployer, "contract %s deployed by %s", msg.Contract.String(), meta.Deployer.String())
+	}
+
+	// an empty field keeps what is stored
+	if len(msg.ABI) != 0 {
+		meta.ABI = msg.ABI
+	}
+	if len(msg.Metadata) != 0 {
+		meta.Metadata = msg.Metadata
+	}
+	k.SetContractMeta(ctx, msg.Contract, *meta)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetContractMeta,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Contract.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgAddDeployer adds an account to the deployer allow-list, the profilers of the
+// guardian module manage the list
+func handleMsgAddDeployer(ctx sdk.Context, msg MsgAddDeployer, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsProfiler(ctx, msg.From) {
+		return nil, sdkerrors.Wrap(types.ErrNotProfiler, msg.From.String())
+	}
+	k.AddDeployer(ctx, msg.Deployer)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddDeployer,
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.Deployer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRemoveDeployer removes an account from the deployer allow-list, the contracts
+// it created are kept
+func handleMsgRemoveDeployer(ctx sdk.Context, msg MsgRemoveDeployer, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsProfiler(ctx, msg.From) {
+		return nil, sdkerrors.Wrap(types.ErrNotProfiler, msg.From.String())
+	}
+	if !k.IsDeployer(ctx, msg.Deployer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not on the deployer allow-list", msg.Deployer.String())
+	}
+	k.RemoveDeployer(ctx, msg.Deployer)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveDeployer,
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.Deployer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// contractAdmin returns the meta of a contract whose admin is from
+func contractAdmin(ctx sdk.Context, k Keeper, contract, from sdk.AccAddress) (*types.ContractMeta, error) {
+	meta := k.GetContractMeta(ctx, contract)
+	if meta == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNoContractMeta, "contract %s", contract.String())
+	}
+	if meta.Admin.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrNotContractAdmin, "contract %s has no admin", contract.String())
+	}
+	if !meta.Admin.Equals(from) {
+		return nil, sdkerrors.Wrapf(types.ErrNotContractAdmin, "contract %s administered by %s", contract.String(), meta.Admin.String())
+	}
+	return meta, nil
+}
+
+// handleMsgContractMigrate replaces the code of a contract, its storage and balance are
+// kept. The code is stored as is, it is not run
+func handleMsgContractMigrate(ctx sdk.Context, msg MsgContractMigrate, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := contractAdmin(ctx, k, msg.Contract, msg.From); err != nil {
+		return nil, err
+	}
+	if len(k.GetCode(ctx, msg.Contract)) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNoCodeExist, "contract %s", msg.Contract.String())
+	}
+	if maxCodeSize := k.GetMaxCodeSize(ctx); uint64(len(msg.Code)) > maxCodeSize {
+		return nil, sdkerrors.Wrapf(types.ErrMaxCodeSizeExceeded, "code size %d, max %d", len(msg.Code), maxCodeSize)
+	}
+
+	// the code is charged as the code of a created contract
+	gasPerByte := k.GetVMContractCreationGasParams(ctx).GasPerByte
+	ctx.GasMeter().ConsumeGas(gasPerByte*uint64(len(msg.Code)), "contract migration code storage")
+
+	codeHash := k.MigrateContract(ctx, msg.Contract, msg.Code)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeContractMigrate,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Data: codeHash.Bytes(), Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgUpdateContractAdmin transfers or clears the admin rights of a contract
+func handleMsgUpdateContractAdmin(ctx sdk.Context, msg MsgUpdateContractAdmin, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := contractAdmin(ctx, k, msg.Contract, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	meta.Admin = msg.NewAdmin
+	k.SetContractMeta(ctx, msg.Contract, *meta)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateAdmin,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.NewAdmin.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgDepositRent charges the rent a contract owes and tops up its deposit, a frozen
+// contract is unfrozen once its deposit covers a block of rent
+func handleMsgDepositRent(ctx sdk.Context, msg MsgDepositRent, k Keeper) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(k.GetCode(ctx, msg.Contract)) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNoCodeExist, "contract %s", msg.Contract.String())
+	}
+
+	if err := k.DepositRent(ctx, msg.From, msg.Contract, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositRent,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Contract.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/Dipper-Labs/Dipper-Protocol/app"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/bank"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/params"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
 	"github.com/Dipper-Labs/Dipper-Protocol/store"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
@@ -47,11 +49,14 @@ func prepareTest(b *testing.B, dbPath string) (k vm.Keeper, addrs []sdk.AccAddre
 	// params
 	paramsKeeper := params.NewKeeper(cdc, protocol.Keys[params.StoreKey], protocol.TKeys[params.TStoreKey])
 	authSubspace := paramsKeeper.Subspace(auth.DefaultParamspace)
+	bankSubspace := paramsKeeper.Subspace(bank.DefaultParamspace)
 	vmSubspace := paramsKeeper.Subspace(vm.DefaultParamspace)
 
 	// keeper
 	accountKeeper := auth.NewAccountKeeper(cdc, protocol.Keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	vmKeeper := vm.NewKeeper(cdc, protocol.Keys[vm.StoreKey], vmSubspace, accountKeeper)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, bankSubspace, nil)
+	supplyKeeper := supply.NewKeeper(cdc, protocol.Keys[supply.StoreKey], accountKeeper, bankKeeper, nil)
+	vmKeeper := vm.NewKeeper(cdc, protocol.Keys[vm.StoreKey], vmSubspace, accountKeeper, bankKeeper, supplyKeeper, nil, nil, nil)
 
 	// new db
 	db, err := sdk.NewLevelDB("application", dbPath)
@@ -64,6 +69,7 @@ func prepareTest(b *testing.B, dbPath string) (k vm.Keeper, addrs []sdk.AccAddre
 	// mount stores
 	ms.MountStoreWithDB(protocol.Keys[params.StoreKey], sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(protocol.Keys[auth.StoreKey], sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(protocol.Keys[supply.StoreKey], sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(protocol.Keys[vm.StoreKey], sdk.StoreTypeIAVL, nil)
 
 	// mount tstores
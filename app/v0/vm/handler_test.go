@@ -1,16 +1,24 @@
 package vm
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math/big"
 	"strings"
 	"testing"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common"
 	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
@@ -90,3 +98,555 @@ func TestMsgContractCreateAndCall(t *testing.T) {
 	}
 
 }
+
+func TestMsgContractReceipt(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+
+	// ./testdata/opCreate
+	code := sdk.FromHex("608060405234801561001057600080fd5b5060008060006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550610230806100616000396000f3fe608060405234801561001057600080fd5b50600436106100365760003560e01c80630dbe671f1461003b578063bf335e6214610085575b600080fd5b61004361008f565b604051808273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200191505060405180910390f35b61008d6100b4565b005b6000809054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b60006040516100c290610192565b604051809103906000f0801580156100de573d6000803e3d6000fd5b509050806000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055505050565b605c8061019f8339019056fe6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea2646970667358221220b405addc262113ddf77e588ca32b50e0a49f3faea9d197a08e25695efdd1408c64736f6c63430006000033a2646970667358221220547e8e8e5af3e1fd635f2d113ac5dba66cc8686d58fb862e15a05827434a39b564736f6c63430006000033")
+	acc := accountKeeper.GetAccount(ctx, keep.Addrs[0])
+	contractAddr := CreateAddress(acc.GetAddress(), acc.GetSequence())
+
+	// successful creation
+	ctx = ctx.WithTxBytes([]byte("create"))
+	_, err := handler(ctx, types.NewMsgContract(acc.GetAddress(), nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+	EndBlocker(ctx, vmKeeper)
+
+	receipt := vmKeeper.GetReceipt(ctx, sdk.BytesToHash(tmhash.Sum(ctx.TxBytes())))
+	require.NotNil(t, receipt)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, contractAddr, receipt.ContractAddress)
+	require.Equal(t, acc.GetAddress(), receipt.From)
+	require.True(t, receipt.GasUsed > 0)
+	require.True(t, receipt.CumulativeGasUsed >= receipt.GasUsed)
+
+	// unknown method, the call reverts
+	ctx = ctx.WithTxBytes([]byte("call"))
+	res, err := handler(ctx, types.NewMsgContract(acc.GetAddress(), contractAddr, sdk.FromHex("deadbeef"), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NotNil(t, err)
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.True(t, strings.Contains(err.Error(), contractAddr.String()))
+	require.Len(t, res.Events, 1)
+	require.Equal(t, types.EventTypeContractFailed, res.Events[0].Type)
+	require.Equal(t, types.AttributeKeyAddress, string(res.Events[0].Attributes[0].Key))
+	require.Equal(t, contractAddr.String(), string(res.Events[0].Attributes[0].Value))
+	require.Equal(t, types.VMErrorClassRevert, string(res.Events[0].Attributes[1].Value))
+	txHash := sdk.BytesToHash(tmhash.Sum(ctx.TxBytes()))
+	require.Nil(t, vmKeeper.GetReceipt(ctx, txHash))
+
+	// the receipt of the failed tx is persisted on commit
+	EndBlocker(ctx, vmKeeper)
+	receipt = vmKeeper.GetReceipt(ctx, txHash)
+	require.NotNil(t, receipt)
+	require.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+	require.True(t, receipt.ContractAddress.Empty())
+	require.Equal(t, contractAddr, receipt.To)
+	require.Empty(t, receipt.Logs)
+}
+
+func TestMsgContractGasPrice(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	vmKeeper.SetMinGasPrice(ctx, 3)
+
+	// the runtime code returns tx.gasprice: GASPRICE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := sdk.FromHex("683a60005260206000f360005260096017f3")
+	contractAddr := CreateAddress(keep.Addrs[0], 0)
+	_, err := handler(ctx, types.NewMsgContract(keep.Addrs[0], nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+
+	// the gas price of the tx
+	res, err := handler(types.WithGasPrice(ctx, sdk.NewInt(7)), types.NewMsgContract(keep.Addrs[1], contractAddr, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.BigToHash(big.NewInt(7)).Bytes(), res.Data)
+
+	// the min gas price without a tx
+	res, err = handler(ctx, types.NewMsgContract(keep.Addrs[1], contractAddr, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.BigToHash(big.NewInt(3)).Bytes(), res.Data)
+}
+
+func TestMsgContractEthCompatible(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	vmKeeper.SetEthCompatible(true)
+
+	// the ante handler has increased the sequence the eth tx was signed with
+	deployer := accountKeeper.GetAccount(ctx, keep.Addrs[0])
+	signed := deployer.GetSequence()
+	require.NoError(t, deployer.SetSequence(signed+1))
+	accountKeeper.SetAccount(ctx, deployer)
+
+	code := sdk.FromHex("683a60005260206000f360005260096017f3")
+	msgCreate := types.NewMsgContract(deployer.GetAddress(), nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+	contractAddr := CreateEthAddress(deployer.GetAddress(), signed)
+	_, err := handler(types.WithSignedNonces(ctx, types.SignedNonces{deployer.GetAddress().String(): signed}), msgCreate)
+	require.Nil(t, err)
+	vmKeeper.StateDB.WithContext(ctx).Finalise(true)
+
+	runtime := sdk.FromHex("3a60005260206000f3")
+	require.Equal(t, runtime, vmKeeper.GetCode(ctx, contractAddr))
+	require.Equal(t, ethcrypto.Keccak256(runtime), accountKeeper.GetAccount(ctx, contractAddr).GetCodeHash())
+	require.Equal(t, signed+1, accountKeeper.GetAccount(ctx, deployer.GetAddress()).GetSequence())
+
+	// a StdTx creates from the sequence it was signed with too and consumes it once, a
+	// second creation of the same tx consumes the next one
+	signed++
+	incrementSequence := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		acc := accountKeeper.GetAccount(ctx, deployer.GetAddress())
+		require.NoError(t, acc.SetSequence(acc.GetSequence()+1))
+		accountKeeper.SetAccount(ctx, acc)
+		return ctx, nil
+	}
+	stdTx := auth.NewStdTx([]sdk.Msg{msgCreate, msgCreate}, auth.StdFee{}, nil, "")
+	txCtx, err := NewSignedNoncesDecorator(accountKeeper).AnteHandle(ctx, stdTx, false, incrementSequence)
+	require.NoError(t, err)
+
+	_, err = handler(txCtx, msgCreate)
+	require.Nil(t, err)
+	vmKeeper.StateDB.WithContext(ctx).Finalise(true)
+	require.Equal(t, runtime, vmKeeper.GetCode(ctx, CreateEthAddress(deployer.GetAddress(), signed)))
+	require.Equal(t, signed+1, accountKeeper.GetAccount(ctx, deployer.GetAddress()).GetSequence())
+
+	_, err = handler(txCtx, msgCreate)
+	require.Nil(t, err)
+	vmKeeper.StateDB.WithContext(ctx).Finalise(true)
+	require.Equal(t, runtime, vmKeeper.GetCode(ctx, CreateEthAddress(deployer.GetAddress(), signed+1)))
+	require.Equal(t, signed+2, accountKeeper.GetAccount(ctx, deployer.GetAddress()).GetSequence())
+
+	// the calls made by a contract leave its nonce as it is, the runtime code calls 0xff
+	caller := CreateEthAddress(deployer.GetAddress(), signed+2)
+	callerCode := sdk.FromHex("6e" + "6000600060006000600060ff5af100" + "600052" + "600f6011f3")
+	_, err = handler(ctx, types.NewMsgContract(deployer.GetAddress(), nil, callerCode, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+	require.NotEmpty(t, vmKeeper.GetCode(ctx, caller))
+	_, err = handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer.GetAddress(), caller, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), vmKeeper.StateDB.WithContext(ctx).GetNonce(caller))
+}
+
+func TestMsgSetContractMeta(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	querier := NewQuerier(vmKeeper)
+
+	bc, err := ioutil.ReadFile("./testdata/opCreate/a.bc")
+	require.NoError(t, err)
+	abiJSON, err := ioutil.ReadFile("./testdata/opCreate/a.abi")
+	require.NoError(t, err)
+
+	deployer := accountKeeper.GetAccount(ctx, keep.Addrs[0])
+	other := accountKeeper.GetAccount(ctx, keep.Addrs[1])
+	contractAddr := CreateAddress(deployer.GetAddress(), deployer.GetSequence())
+	code := sdk.FromHex(strings.TrimSpace(string(bc)))
+
+	// the abi is stored with the contract
+	msg := types.NewMsgContract(deployer.GetAddress(), nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0)).WithContractMeta(string(abiJSON), "")
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+
+	meta := vmKeeper.GetContractMeta(ctx, contractAddr)
+	require.NotNil(t, meta)
+	require.Equal(t, deployer.GetAddress(), meta.Deployer)
+	require.Equal(t, string(abiJSON), meta.ABI)
+	require.Empty(t, meta.Metadata)
+
+	// only the deployer can set the metadata
+	_, err = handler(ctx, types.NewMsgSetContractMeta(other.GetAddress(), contractAddr, "", "metadata"))
+	require.True(t, types.ErrNotContractDeployer.Is(err))
+
+	_, err = handler(ctx, types.NewMsgSetContractMeta(deployer.GetAddress(), contractAddr, "", "metadata"))
+	require.NoError(t, err)
+	meta = vmKeeper.GetContractMeta(ctx, contractAddr)
+	require.Equal(t, string(abiJSON), meta.ABI)
+	require.Equal(t, "metadata", meta.Metadata)
+
+	// a contract created without abi records its deployer
+	deployer = accountKeeper.GetAccount(ctx, keep.Addrs[2])
+	otherContract := CreateAddress(deployer.GetAddress(), deployer.GetSequence())
+	_, err = handler(ctx, types.NewMsgContract(deployer.GetAddress(), nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+
+	meta = vmKeeper.GetContractMeta(ctx, otherContract)
+	require.NotNil(t, meta)
+	require.Empty(t, meta.ABI)
+	_, err = handler(ctx, types.NewMsgSetContractMeta(deployer.GetAddress(), otherContract, string(abiJSON), ""))
+	require.NoError(t, err)
+
+	bz, err := querier(ctx, []string{types.QueryContractMeta, otherContract.String()}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var queried types.ContractMeta
+	vmKeeper.Cdc.MustUnmarshalJSON(bz, &queried)
+	require.Equal(t, deployer.GetAddress(), queried.Deployer)
+	require.Equal(t, string(abiJSON), queried.ABI)
+
+	// no metadata for accounts which are not contracts
+	_, err = handler(ctx, types.NewMsgSetContractMeta(deployer.GetAddress(), other.GetAddress(), string(abiJSON), ""))
+	require.True(t, types.ErrNoContractMeta.Is(err))
+	_, err = querier(ctx, []string{types.QueryContractMeta, other.GetAddress().String()}, abci.RequestQuery{})
+	require.True(t, types.ErrNoContractMeta.Is(err))
+	// a contract created by a contract records the tx sender as its deployer, the factory
+	// creates a child whose runtime code is a STOP
+	factoryCode := sdk.FromHex("69600060005360016000f3" + "600052" + "600a60166000f0" + "50" + "600060005360016000f3")
+	deployer = accountKeeper.GetAccount(ctx, keep.Addrs[3])
+	factory := CreateAddress(deployer.GetAddress(), deployer.GetSequence())
+	child := CreateAddress(factory, 0)
+	_, err = handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer.GetAddress(), nil, factoryCode, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+	require.Equal(t, []byte{0}, vmKeeper.GetCode(ctx, child))
+	meta = vmKeeper.GetContractMeta(ctx, child)
+	require.NotNil(t, meta)
+	require.Equal(t, deployer.GetAddress(), meta.Deployer)
+	_, err = handler(ctx, types.NewMsgSetContractMeta(deployer.GetAddress(), child, string(abiJSON), ""))
+	require.NoError(t, err)
+
+	// the admin can set the metadata too
+	meta.Admin = other.GetAddress()
+	vmKeeper.SetContractMeta(ctx, child, *meta)
+	_, err = handler(ctx, types.NewMsgSetContractMeta(other.GetAddress(), child, "", "metadata"))
+	require.NoError(t, err)
+	require.Equal(t, "metadata", vmKeeper.GetContractMeta(ctx, child).Metadata)
+
+	// a contract created before the metadata was recorded gets it from a profiler
+	legacy := sdk.AccAddress([]byte("legacy_contract_____"))
+	stateDB := vmKeeper.StateDB.WithContext(ctx)
+	stateDB.SetCode(legacy, []byte{0})
+	stateDB.Finalise(true)
+	_, err = handler(ctx, types.NewMsgSetContractMeta(deployer.GetAddress(), legacy, string(abiJSON), ""))
+	require.True(t, types.ErrNoContractMeta.Is(err))
+
+	guardianKeeper := vmKeeper.GuardianKeeper().(guardian.Keeper)
+	require.NoError(t, guardianKeeper.AddProfiler(ctx, guardian.NewGuardian("profiler", guardian.Ordinary, other.GetAddress(), other.GetAddress())))
+	_, err = handler(ctx, types.NewMsgSetContractMeta(other.GetAddress(), legacy, string(abiJSON), ""))
+	require.NoError(t, err)
+	meta = vmKeeper.GetContractMeta(ctx, legacy)
+	require.NotNil(t, meta)
+	require.Equal(t, other.GetAddress(), meta.Deployer)
+	require.Equal(t, string(abiJSON), meta.ABI)
+}
+
+func TestDeploymentMode(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+	// factoryCode deploys a contract creating an empty contract, it reverts if the creation fails
+	factoryCode := sdk.FromHex("601280600b6000396000f3" + "600060006000f08015600d57005b600080fd")
+	create := func(from sdk.AccAddress) (sdk.AccAddress, error) {
+		acc := accountKeeper.GetAccount(ctx, from)
+		contractAddr := CreateAddress(acc.GetAddress(), acc.GetSequence())
+		_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(from, nil, factoryCode, zero))
+		return contractAddr, err
+	}
+	call := func(from, contractAddr sdk.AccAddress) error {
+		_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(from, contractAddr, []byte{0}, zero))
+		return err
+	}
+
+	// any account creates contracts in the open mode
+	require.Equal(t, types.DeploymentModeOpen, vmKeeper.GetDeploymentMode(ctx))
+	_, err := create(keep.Addrs[2])
+	require.NoError(t, err)
+
+	vmKeeper.SetDeploymentMode(ctx, types.DeploymentModeAllowList)
+	deployer, other, profiler := keep.Addrs[0], keep.Addrs[1], keep.Addrs[5]
+	_, err = create(deployer)
+	require.True(t, types.ErrDeploymentNotAllowed.Is(err))
+
+	// the profilers of the guardian module manage the allow-list
+	_, err = handler(ctx, types.NewMsgAddDeployer(other, deployer))
+	require.True(t, types.ErrNotProfiler.Is(err))
+	guardianKeeper := vmKeeper.GuardianKeeper().(guardian.Keeper)
+	require.NoError(t, guardianKeeper.AddProfiler(ctx, guardian.NewGuardian("profiler", guardian.Ordinary, profiler, profiler)))
+	_, err = handler(ctx, types.NewMsgAddDeployer(profiler, deployer))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{deployer}, vmKeeper.GetDeployers(ctx))
+
+	factory, err := create(deployer)
+	require.NoError(t, err)
+
+	// the contracts created by contracts are subject to the mode, the origin of the tx decides
+	require.NoError(t, call(deployer, factory))
+	require.True(t, ErrExecutionReverted.Is(call(other, factory)))
+
+	_, err = handler(ctx, types.NewMsgRemoveDeployer(profiler, deployer))
+	require.NoError(t, err)
+	require.Empty(t, vmKeeper.GetDeployers(ctx))
+	require.True(t, ErrExecutionReverted.Is(call(deployer, factory)))
+
+	// only the profilers create contracts in the guardian mode
+	vmKeeper.SetDeploymentMode(ctx, types.DeploymentModeGuardian)
+	_, err = create(other)
+	require.True(t, types.ErrDeploymentNotAllowed.Is(err))
+	_, err = create(profiler)
+	require.NoError(t, err)
+}
+
+func TestMsgContractMigrate(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+	deployer, admin, other := keep.Addrs[0], keep.Addrs[1], keep.Addrs[2]
+	// the contract stores 42 at slot 0 and its runtime code returns slot 0, the migrated code
+	// returns slot 0 plus one
+	initCode := sdk.FromHex("602a600055" + "600b8060106000396000f3" + "60005460005260206000f3")
+	migratedCode := sdk.FromHex("60005460010160005260206000f3")
+	call := func(contractAddr sdk.AccAddress) *big.Int {
+		res, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(other, contractAddr, []byte{0}, zero))
+		require.NoError(t, err)
+		return new(big.Int).SetBytes(res.Data)
+	}
+
+	contractAddr := CreateAddress(deployer, 0)
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer, nil, initCode, zero).WithAdmin(admin))
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+	require.Equal(t, admin, vmKeeper.GetContractMeta(ctx, contractAddr).Admin)
+	require.Equal(t, int64(42), call(contractAddr).Int64())
+
+	// only the admin migrates the contract
+	_, err = handler(ctx, types.NewMsgContractMigrate(deployer, contractAddr, migratedCode))
+	require.True(t, types.ErrNotContractAdmin.Is(err))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	res, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContractMigrate(admin, contractAddr, migratedCode))
+	require.NoError(t, err)
+	codeHash := sdk.BytesToHash(res.Data)
+	require.Equal(t, codeHash, vmKeeper.StateDB.WithContext(ctx).GetCodeHash(contractAddr))
+	EndBlocker(ctx, vmKeeper)
+
+	// the storage is kept
+	require.Equal(t, migratedCode, vmKeeper.GetCode(ctx, contractAddr))
+	require.Equal(t, int64(43), call(contractAddr).Int64())
+
+	history := vmKeeper.GetCodeHistory(ctx, contractAddr)
+	require.Len(t, history, 2)
+	require.Equal(t, types.CodeHistoryOperationCreate, history[0].Operation)
+	require.Equal(t, ctx.BlockHeight()-1, history[0].Height)
+	require.Equal(t, types.NewCodeHistoryEntry(types.CodeHistoryOperationMigrate, codeHash, ctx.BlockHeight()), history[1])
+
+	// the admin rights are transferred then cleared, the code is final without an admin
+	_, err = handler(ctx, types.NewMsgUpdateContractAdmin(other, contractAddr, other))
+	require.True(t, types.ErrNotContractAdmin.Is(err))
+	_, err = handler(ctx, types.NewMsgUpdateContractAdmin(admin, contractAddr, other))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgContractMigrate(admin, contractAddr, initCode))
+	require.True(t, types.ErrNotContractAdmin.Is(err))
+	_, err = handler(ctx, types.NewMsgUpdateContractAdmin(other, contractAddr, nil))
+	require.NoError(t, err)
+	require.Empty(t, vmKeeper.GetContractMeta(ctx, contractAddr).Admin)
+	_, err = handler(ctx, types.NewMsgContractMigrate(other, contractAddr, initCode))
+	require.True(t, types.ErrNotContractAdmin.Is(err))
+}
+
+func TestStorageRent(t *testing.T) {
+	ctx, _, vmKeeper, supplyKeeper := keep.CreateTestInput(t, false, 1000000)
+	ctx = ctx.WithBlockHeight(1)
+	vmKeeper.SetStorageRent(true)
+	handler := NewHandler(vmKeeper)
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+	deployer, payer := keep.Addrs[0], keep.Addrs[1]
+	// the contract stores 42 at slot 0 and its runtime code returns slot 0
+	initCode := sdk.FromHex("602a600055" + "600b8060106000396000f3" + "60005460005260206000f3")
+	call := func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+		_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(payer, contractAddr, []byte{0}, zero))
+		return err
+	}
+	deposit := func(ctx sdk.Context, contractAddr sdk.AccAddress, amount int64) error {
+		_, err := handler(ctx, types.NewMsgDepositRent(payer, contractAddr, sdk.NewInt64Coin(sdk.NativeTokenName, amount)))
+		return err
+	}
+	collected := func() sdk.Int {
+		return supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins().AmountOf(sdk.NativeTokenName)
+	}
+
+	// the rent of the first storage is owed after the grace period
+	grace := ctx.BlockHeight() + types.StorageRentGracePeriod
+	contractAddr := CreateAddress(deployer, 0)
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer, nil, initCode, zero))
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+	require.Equal(t, types.NewContractRent(types.StorageSlotBytes, sdk.ZeroInt(), false, grace), vmKeeper.GetContractRent(ctx, contractAddr))
+
+	vmKeeper.SetStorageRentRate(ctx, 1)
+	require.NoError(t, call(ctx.WithBlockHeight(grace), contractAddr))
+	require.True(t, collected().IsZero())
+	require.NoError(t, deposit(ctx.WithBlockHeight(grace), contractAddr, 100))
+	require.Equal(t, types.NewContractRent(types.StorageSlotBytes, sdk.NewInt(100), false, grace), vmKeeper.GetContractRent(ctx, contractAddr))
+
+	// the rent owed is charged when the contract is called
+	require.NoError(t, call(ctx.WithBlockHeight(grace+1), contractAddr))
+	require.Equal(t, types.NewContractRent(types.StorageSlotBytes, sdk.NewInt(100-types.StorageSlotBytes), false, grace+1), vmKeeper.GetContractRent(ctx, contractAddr))
+	require.Equal(t, sdk.NewInt(types.StorageSlotBytes), collected())
+
+	// the contract is frozen once its deposit does not cover the rent it owes, the rest is taken
+	require.True(t, vmKeeper.IsFrozen(ctx.WithBlockHeight(grace+3), contractAddr))
+	require.True(t, types.ErrContractFrozen.Is(call(ctx.WithBlockHeight(grace+3), contractAddr)))
+	require.Equal(t, types.NewContractRent(types.StorageSlotBytes, sdk.ZeroInt(), true, grace+3), vmKeeper.GetContractRent(ctx, contractAddr))
+	require.Equal(t, sdk.NewInt(100), collected())
+
+	// a frozen contract is not charged, a deposit covering a block of rent unfreezes it
+	later := ctx.WithBlockHeight(grace + 10)
+	require.NoError(t, deposit(later, contractAddr, types.StorageSlotBytes-1))
+	require.True(t, vmKeeper.IsFrozen(later, contractAddr))
+	require.NoError(t, deposit(later, contractAddr, 1))
+	require.False(t, vmKeeper.IsFrozen(later, contractAddr))
+	require.NoError(t, call(later, contractAddr))
+	require.Equal(t, sdk.NewInt(100), collected())
+
+	require.True(t, types.ErrNoCodeExist.Is(deposit(later, payer, 1)))
+}
+
+func TestContractHalted(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	ctx = ctx.WithBlockHeight(10)
+	handler := NewHandler(vmKeeper)
+	guardianKeeper := vmKeeper.GuardianKeeper().(guardian.Keeper)
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+	sender := keep.Addrs[2]
+	call := func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+		_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(sender, contractAddr, []byte{0}, zero))
+		return err
+	}
+
+	// the callee returns slot 0, the runtime code of the caller calls it and reverts when
+	// the call fails
+	callee := CreateAddress(keep.Addrs[0], 0)
+	calleeCode := sdk.FromHex("602a600055" + "600b8060106000396000f3" + "60005460005260206000f3")
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(keep.Addrs[0], nil, calleeCode, zero))
+	require.NoError(t, err)
+	caller := CreateAddress(keep.Addrs[1], 0)
+	callerCode := sdk.FromHex("602b80600b6000396000f3" + "60006000600060006000" + fmt.Sprintf("73%x", callee.Bytes()) + "5af1" + "15602657" + "00" + "5b600080fd")
+	_, err = handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(keep.Addrs[1], nil, callerCode, zero))
+	require.NoError(t, err)
+	require.NoError(t, call(ctx, callee))
+	require.NoError(t, call(ctx, caller))
+
+	// the halted contract is not run when called by another contract either
+	guardianKeeper.SetDisabledRoute(ctx, guardian.NewDisabledRoute(callee.String(), 10, 12, keep.Addrs[0]))
+	require.True(t, types.ErrContractHalted.Is(call(ctx, callee)))
+	require.True(t, types.ErrExecutionReverted.Is(call(ctx, caller)))
+
+	// the msgs of a passed proposal run it
+	require.NoError(t, call(govtypes.WithProposalMsg(ctx, govtypes.NewProposalMsg(1, 0)), caller))
+
+	// the contract runs again at the expiry height
+	require.NoError(t, call(ctx.WithBlockHeight(12), caller))
+}
+
+func TestExportSnapshot(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	// the slots are recorded from the storage rent version on
+	vmKeeper.SetStorageRent(true)
+	handler := NewHandler(vmKeeper)
+	deployer := keep.Addrs[0]
+	initCode := sdk.FromHex("602a600055" + "600b8060106000396000f3" + "60005460005260206000f3")
+
+	contractAddr := CreateAddress(deployer, 0)
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer, nil, initCode, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	EndBlocker(ctx, vmKeeper)
+
+	var buf bytes.Buffer
+	require.NoError(t, vmKeeper.StateDB.WithContext(ctx).ExportSnapshot(&buf, types.DefaultSnapshotChunkSize))
+
+	sr, err := types.NewSnapshotReader(&buf)
+	require.NoError(t, err)
+	alloc, skipped, err := types.SnapshotToGethAlloc(sr)
+	require.NoError(t, err)
+	require.Equal(t, 0, skipped)
+
+	// the slot the contract wrote is exported with its key
+	contract := alloc[ethcmn.BytesToAddress(contractAddr)]
+	require.Equal(t, sdk.FromHex("60005460005260206000f3"), contract.Code)
+	require.Equal(t, map[ethcmn.Hash]ethcmn.Hash{{}: ethcmn.BigToHash(big.NewInt(42))}, contract.Storage)
+	require.Contains(t, alloc, ethcmn.BytesToAddress(deployer))
+}
+
+func TestMsgContractAccessList(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	deployer := keep.Addrs[0]
+	initCode := sdk.FromHex("602a600055" + "600b8060106000396000f3" + "60005460005260206000f3")
+
+	contractAddr := CreateAddress(deployer, 0)
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(deployer, nil, initCode, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+
+	// the calls run on the same state
+	call := func(sender sdk.AccAddress, accessList types.AccessList) (uint64, error) {
+		gasMeter := sdk.NewGasMeter(10000000)
+		cacheCtx, _ := ctx.CacheContext()
+		msg := types.NewMsgContract(sender, contractAddr, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0)).WithAccessList(accessList)
+		res, err := handler(cacheCtx.WithGasMeter(gasMeter), msg)
+		if err == nil {
+			require.Equal(t, sdk.BigToHash(big.NewInt(42)).Bytes(), res.Data)
+		}
+		return gasMeter.GasConsumed(), err
+	}
+	accessList := types.AccessList{{Address: contractAddr, StorageKeys: []sdk.Hash{{}}}}
+
+	// access lists are rejected before berlin
+	_, err = call(keep.Addrs[1], accessList)
+	require.True(t, types.ErrAccessListNotSupported.Is(err))
+
+	vmKeeper.SetFork(types.ForkBerlin)
+	vmKeeper.SetVMOpGasParams(ctx, BerlinVMOpGasParams(vmKeeper.GetVMOpGasParams(ctx)))
+	handler = NewHandler(vmKeeper)
+
+	// the first call caches the accounts in the statedb
+	_, err = call(keep.Addrs[1], nil)
+	require.NoError(t, err)
+	coldGas, err := call(keep.Addrs[1], nil)
+	require.NoError(t, err)
+
+	// the listed slot is warm, the list costs its address and its key
+	warmGas, err := call(keep.Addrs[1], accessList)
+	require.NoError(t, err)
+	require.Equal(t, coldGas+TxAccessListAddressGas+TxAccessListStorageKeyGas-ColdSloadCostEIP2929+WarmStorageReadCostEIP2929, warmGas)
+}
+
+func TestCancunOpcodes(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	vmKeeper.SetMinGasPrice(ctx, 3)
+	vmKeeper.SetFork(types.ForkBerlin)
+	vmKeeper.SetVMOpGasParams(ctx, BerlinVMOpGasParams(vmKeeper.GetVMOpGasParams(ctx)))
+	handler := NewHandler(vmKeeper)
+
+	// the runtime code returns the transient slot 0 stored and copied by MCOPY, BASEFEE and
+	// the transient slot 0 at the start of the call:
+	// PUSH0 TLOAD PUSH1 96 MSTORE PUSH1 42 PUSH0 TSTORE PUSH0 TLOAD PUSH0 MSTORE
+	// PUSH1 32 PUSH0 PUSH1 32 MCOPY BASEFEE PUSH1 64 MSTORE PUSH1 96 PUSH1 32 RETURN
+	runtime := "5f5c606052602a5f5d5f5c5f5260205f60205e4860405260606020f3"
+	initCode := sdk.FromHex("601c80600b6000396000f3" + runtime)
+
+	contractAddr := CreateAddress(keep.Addrs[0], 0)
+	_, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), types.NewMsgContract(keep.Addrs[0], nil, initCode, sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+
+	msg := types.NewMsgContract(keep.Addrs[1], contractAddr, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+
+	// the opcodes are invalid before cancun, the call runs as a query on the code committed at
+	// the end of the block since it burns all its gas
+	EndBlocker(ctx, vmKeeper)
+	_, _, err = DoStateTransition(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), msg, vmKeeper, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid opcode")
+
+	vmKeeper.SetFork(types.ForkCancun)
+	vmKeeper.SetVMOpGasParams(ctx, CancunVMOpGasParams(vmKeeper.GetVMOpGasParams(ctx)))
+	handler = NewHandler(vmKeeper)
+
+	// the transient storage of a tx is not seen by the next one
+	for i := 0; i < 2; i++ {
+		res, err := handler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), msg)
+		require.NoError(t, err)
+		expected := append(sdk.BigToHash(big.NewInt(42)).Bytes(), sdk.BigToHash(big.NewInt(3)).Bytes()...)
+		expected = append(expected, make([]byte, 32)...)
+		require.Equal(t, expected, res.Data)
+	}
+}
@@ -914,3 +914,42 @@ func opChainID(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memo
 	stack.push(chainID)
 	return nil, nil
 }
+
+func opBaseFee(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(interpreter.intPool.get().Set(interpreter.evm.BaseFee))
+	return nil, nil
+}
+
+func opPush0(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(interpreter.intPool.getZero())
+	return nil, nil
+}
+
+func opMcopy(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	var (
+		dst    = stack.pop()
+		src    = stack.pop()
+		length = stack.pop()
+	)
+	// the memory was resized to fit both the source and the destination
+	memory.Copy(dst.Uint64(), src.Uint64(), length.Uint64())
+
+	interpreter.intPool.put(dst, src, length)
+	return nil, nil
+}
+
+func opTload(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := stack.peek()
+	val := interpreter.evm.StateDB.GetTransientState(contract.Address(), sdk.BigToHash(loc))
+	loc.SetBytes(val.Bytes())
+	return nil, nil
+}
+
+func opTstore(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := sdk.BigToHash(stack.pop())
+	val := stack.pop()
+	interpreter.evm.StateDB.SetTransientState(contract.Address(), loc, sdk.BigToHash(val))
+
+	interpreter.intPool.put(val)
+	return nil, nil
+}
@@ -22,6 +22,12 @@ type Config struct {
 	MaxCodeSize               uint64
 	MaxCallCreateDepth        uint64
 
+	Precompiles PrecompileResolver // Resolves the precompiled contracts of the registry and the ERC-20 contracts of the issued tokens
+	CanDeploy   CanDeployFunc      // Restricts the accounts whose txs create contracts, nil lets every account do so
+	IsFrozen    IsFrozenFunc       // Rejects the calls to the contracts whose storage rent deposit ran out, nil freezes none
+	IsHalted    IsHaltedFunc       // Rejects the calls to the contracts disabled by the guardians, nil halts none
+	Fork        types.Fork         // Selects the instruction set when JumpTable is unset and whether the accesses are tracked
+
 	EWASMInterpreter string // External EWASM interpreter options
 	EVMInterpreter   string // External EVM interpreter options
 }
@@ -154,13 +160,13 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 		operation := in.cfg.JumpTable[op]
 		operation.constantGas = in.cfg.OpConstGasConfig[op]
 		if !operation.valid {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInternal, "invalid opcode 0x%x", int(op))
+			return nil, sdkerrors.Wrapf(ErrInvalidOpcode, "0x%x", int(op))
 		}
 		// Validate stack
 		if sLen := stack.len(); sLen < operation.minStack {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInternal, "stack underflow (%d <=> %d)", sLen, operation.minStack)
+			return nil, sdkerrors.Wrapf(ErrStackUnderflow, "%d <=> %d", sLen, operation.minStack)
 		} else if sLen > operation.maxStack {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInternal, "stack limit reached %d (%d)", sLen, operation.maxStack)
+			return nil, sdkerrors.Wrapf(ErrStackOverflow, "%d (%d)", sLen, operation.maxStack)
 		}
 		// If the operation is valid, enforce and write restrictions
 		if in.readOnly {
@@ -170,13 +176,13 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 			// account to the others means the state is modified and should also
 			// return with an error.
 			if operation.writes || (op == CALL && stack.Back(2).Sign() != 0) {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrInternal, "errWriteProtection")
+				return nil, ErrWriteProtection
 			}
 		}
 		// Static portion of gas
 		cost = operation.constantGas // For tracing
 		if !contract.UseGas(operation.constantGas) {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInternal, "ErrOutOfGas")
+			return nil, ErrOutOfGas
 		}
 
 		var memorySize uint64
@@ -187,12 +193,12 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 		if operation.memorySize != nil {
 			memSize, overflow := operation.memorySize(stack)
 			if overflow {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrInternal, "errGasUintOverflow")
+				return nil, ErrGasUintOverflow
 			}
 			// memory is expanded in words of 32 bytes. Gas
 			// is also calculated in words.
 			if memorySize, overflow = math.SafeMul(toWordSize(memSize), 32); overflow {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrInternal, "errGasUintOverflow")
+				return nil, ErrGasUintOverflow
 			}
 		}
 		// Dynamic portion of gas
@@ -203,7 +209,7 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 			dynamicCost, err = operation.dynamicGas(in.evm, contract, stack, mem, memorySize)
 			cost += dynamicCost // total cost, for debug tracing
 			if err != nil || !contract.UseGas(dynamicCost) {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrInternal, "ErrOutOfGas")
+				return nil, ErrOutOfGas
 			}
 		}
 		if memorySize > 0 {
@@ -250,8 +256,7 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 // run by the current interpreter.
 func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	if !cfg.JumpTable[STOP].valid {
-		jt := istanbulInstructionSet
-		cfg.JumpTable = jt
+		cfg.JumpTable = instructionSet(cfg.Fork)
 	}
 
 	return &EVMInterpreter{
@@ -1,5 +1,9 @@
 package vm
 
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+)
+
 type (
 	executionFunc func(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error)
 	gasFunc       func(*EVM, *Contract, *Stack, *Memory, uint64) (uint64, error) // last parameter is the requested memory size as a uint64
@@ -34,8 +38,108 @@ type JumpTable [256]operation
 
 var (
 	istanbulInstructionSet = newIstanbulInstructionSet()
+	berlinInstructionSet   = newBerlinInstructionSet()
+	cancunInstructionSet   = newCancunInstructionSet()
 )
 
+// eip2929Ops are the opcodes whose constant gas is the warm access cost from the berlin fork on
+var eip2929Ops = []OpCode{SLOAD, BALANCE, EXTCODESIZE, EXTCODECOPY, EXTCODEHASH, CALL, CALLCODE, DELEGATECALL, STATICCALL}
+
+// instructionSet returns the jump table of the fork
+func instructionSet(fork types.Fork) JumpTable {
+	switch fork {
+	case types.ForkCancun:
+		return cancunInstructionSet
+	case types.ForkBerlin:
+		return berlinInstructionSet
+	default:
+		return istanbulInstructionSet
+	}
+}
+
+// BerlinVMOpGasParams returns the constant gas of the opcodes with the warm access cost of
+// EIP 2929 for the opcodes accessing accounts and storage, the cold cost is charged on top
+func BerlinVMOpGasParams(params [256]uint64) [256]uint64 {
+	for _, op := range eip2929Ops {
+		params[op] = WarmStorageReadCostEIP2929
+	}
+	return params
+}
+
+// CancunVMOpGasParams returns the constant gas of the opcodes with the costs of the opcodes
+// added up to the cancun fork, they were invalid and free before
+func CancunVMOpGasParams(params [256]uint64) [256]uint64 {
+	params[BASEFEE] = GasQuickStep
+	params[PUSH0] = GasQuickStep
+	params[MCOPY] = GasFastestStep
+	params[TLOAD] = WarmStorageReadCostEIP2929
+	params[TSTORE] = WarmStorageReadCostEIP2929
+	return params
+}
+
+// newCancunInstructionSet returns the berlin instructions with BASEFEE (EIP 3198), PUSH0
+// (EIP 3855), MCOPY (EIP 5656) and the transient storage of TLOAD and TSTORE (EIP 1153)
+func newCancunInstructionSet() JumpTable {
+	instructionSet := newBerlinInstructionSet()
+	instructionSet[BASEFEE] = operation{
+		execute:     opBaseFee,
+		constantGas: GasQuickStep,
+		minStack:    minStack(0, 1),
+		maxStack:    maxStack(0, 1),
+		valid:       true,
+	}
+	instructionSet[PUSH0] = operation{
+		execute:     opPush0,
+		constantGas: GasQuickStep,
+		minStack:    minStack(0, 1),
+		maxStack:    maxStack(0, 1),
+		valid:       true,
+	}
+	instructionSet[MCOPY] = operation{
+		execute:     opMcopy,
+		constantGas: GasFastestStep,
+		dynamicGas:  gasMcopy,
+		minStack:    minStack(3, 0),
+		maxStack:    maxStack(3, 0),
+		memorySize:  memoryMcopy,
+		valid:       true,
+	}
+	instructionSet[TLOAD] = operation{
+		execute:     opTload,
+		constantGas: WarmStorageReadCostEIP2929,
+		minStack:    minStack(1, 1),
+		maxStack:    maxStack(1, 1),
+		valid:       true,
+	}
+	instructionSet[TSTORE] = operation{
+		execute:     opTstore,
+		constantGas: WarmStorageReadCostEIP2929,
+		minStack:    minStack(2, 0),
+		maxStack:    maxStack(2, 0),
+		valid:       true,
+		writes:      true,
+	}
+	return instructionSet
+}
+
+// newBerlinInstructionSet returns the istanbul instructions priced with the warm and cold
+// accesses of EIP 2929
+func newBerlinInstructionSet() JumpTable {
+	instructionSet := newIstanbulInstructionSet()
+	instructionSet[SLOAD].dynamicGas = gasSLoadEIP2929
+	instructionSet[SSTORE].dynamicGas = gasSStoreEIP2929
+	instructionSet[BALANCE].dynamicGas = gasAccountCheckEIP2929
+	instructionSet[EXTCODESIZE].dynamicGas = gasAccountCheckEIP2929
+	instructionSet[EXTCODEHASH].dynamicGas = gasAccountCheckEIP2929
+	instructionSet[EXTCODECOPY].dynamicGas = gasExtCodeCopyEIP2929
+	instructionSet[CALL].dynamicGas = gasCallEIP2929
+	instructionSet[CALLCODE].dynamicGas = gasCallCodeEIP2929
+	instructionSet[DELEGATECALL].dynamicGas = gasDelegateCallEIP2929
+	instructionSet[STATICCALL].dynamicGas = gasStaticCallEIP2929
+	instructionSet[SELFDESTRUCT].dynamicGas = gasSelfdestructEIP2929
+	return instructionSet
+}
+
 // newIstanbulInstructionSet
 func newIstanbulInstructionSet() JumpTable {
 	return JumpTable{
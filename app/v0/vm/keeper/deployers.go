@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// AddDeployer adds an account to the deployer allow-list
+func (k Keeper) AddDeployer(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.DeployerKey(addr), []byte{1})
+}
+
+// RemoveDeployer removes an account from the deployer allow-list
+func (k Keeper) RemoveDeployer(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DeployerKey(addr))
+}
+
+// IsDeployer returns whether an account is on the deployer allow-list
+func (k Keeper) IsDeployer(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DeployerKey(addr))
+}
+
+// GetDeployers returns the accounts of the deployer allow-list
+func (k Keeper) GetDeployers(ctx sdk.Context) (deployers []sdk.AccAddress) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixDeployers)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		deployers = append(deployers, sdk.AccAddress(iterator.Key()[len(types.KeyPrefixDeployers):]))
+	}
+	return
+}
+
+// IsProfiler returns whether an account is a profiler of the guardian module
+func (k Keeper) IsProfiler(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.gk == nil {
+		return false
+	}
+	_, found := k.gk.GetProfiler(ctx, addr)
+	return found
+}
+
+// IsContractHalted returns whether the guardians disabled the calls of a contract
+func (k Keeper) IsContractHalted(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.gk == nil {
+		return false
+	}
+	return k.gk.IsRouteDisabled(ctx, addr.String())
+}
+
+// CanDeploy returns whether the deployment mode lets an account create contracts
+func (k Keeper) CanDeploy(ctx sdk.Context, addr sdk.AccAddress) bool {
+	switch k.GetDeploymentMode(ctx) {
+	case types.DeploymentModeAllowList:
+		return k.IsDeployer(ctx, addr)
+	case types.DeploymentModeGuardian:
+		return k.IsProfiler(ctx, addr)
+	default:
+		return true
+	}
+}
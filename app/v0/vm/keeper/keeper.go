@@ -14,15 +14,33 @@ import (
 
 type Keeper struct {
 	Cdc        *codec.Codec
+	storeKey   sdk.StoreKey
 	paramstore params.Subspace
+	ak         auth.AccountKeeper
+	bk         types.BankKeeper
+	sk         types.SupplyKeeper
+	stk        types.StakingKeeper
+	dk         types.DistributionKeeper
+	gk         types.GuardianKeeper
 	StateDB    *types.CommitStateDB
+
+	// fork selects the instruction set and the gas rules of the vm
+	fork types.Fork
 }
 
 // NewKeeper returns vm keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramstore params.Subspace, ak auth.AccountKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramstore params.Subspace, ak auth.AccountKeeper, bk types.BankKeeper, sk types.SupplyKeeper,
+	stk types.StakingKeeper, dk types.DistributionKeeper, gk types.GuardianKeeper) Keeper {
 	return Keeper{
 		Cdc:        cdc,
+		storeKey:   storeKey,
 		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		ak:         ak,
+		bk:         bk,
+		sk:         sk,
+		stk:        stk,
+		dk:         dk,
+		gk:         gk,
 		StateDB:    types.NewCommitStateDB(ak, storeKey),
 	}
 }
@@ -32,6 +50,31 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("modules/%s", types.ModuleName))
 }
 
+// BankKeeper returns the bank keeper the precompiled contracts send coins with
+func (k Keeper) BankKeeper() types.BankKeeper {
+	return k.bk
+}
+
+// SupplyKeeper returns the supply keeper the precompiled contracts look the issued tokens up with
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.sk
+}
+
+// StakingKeeper returns the staking keeper the precompiled contracts delegate with
+func (k Keeper) StakingKeeper() types.StakingKeeper {
+	return k.stk
+}
+
+// DistributionKeeper returns the distribution keeper the precompiled contracts withdraw rewards with
+func (k Keeper) DistributionKeeper() types.DistributionKeeper {
+	return k.dk
+}
+
+// GuardianKeeper returns the guardian keeper whose profilers manage the deployments
+func (k Keeper) GuardianKeeper() types.GuardianKeeper {
+	return k.gk
+}
+
 func (k Keeper) GetState(ctx sdk.Context, addr sdk.AccAddress, hash sdk.Hash) sdk.Hash {
 	return k.StateDB.WithContext(ctx).GetState(addr, hash)
 }
@@ -44,6 +87,71 @@ func (k *Keeper) GetLogs(ctx sdk.Context, hash sdk.Hash) []*types.Log {
 	return k.StateDB.WithContext(ctx).GetLogs(hash)
 }
 
+// FilterLogs returns the first limit logs matching the filter, all of them when limit is 0
+func (k *Keeper) FilterLogs(ctx sdk.Context, filter types.LogFilter, limit int) []*types.Log {
+	return k.StateDB.WithContext(ctx).FilterLogs(filter, limit)
+}
+
+func (k *Keeper) GetReceipt(ctx sdk.Context, hash sdk.Hash) *types.Receipt {
+	return k.StateDB.WithContext(ctx).GetReceipt(hash)
+}
+
+func (k *Keeper) GetContractMeta(ctx sdk.Context, addr sdk.AccAddress) *types.ContractMeta {
+	return k.StateDB.WithContext(ctx).GetContractMeta(addr)
+}
+
+func (k *Keeper) SetContractMeta(ctx sdk.Context, addr sdk.AccAddress, meta types.ContractMeta) {
+	k.StateDB.WithContext(ctx).SetContractMeta(addr, meta)
+}
+
+// MigrateContract replaces the code of a contract and keeps its storage and balance, the
+// code is recorded in the code history of the contract
+func (k *Keeper) MigrateContract(ctx sdk.Context, addr sdk.AccAddress, code []byte) sdk.Hash {
+	stateDB := k.StateDB.WithContext(ctx)
+	stateDB.UpdateAccounts()
+	stateDB.SetCode(addr, code)
+	stateDB.Finalise(true)
+
+	codeHash := stateDB.GetCodeHash(addr)
+	stateDB.AppendCodeHistory(addr, types.CodeHistoryOperationMigrate, codeHash)
+	return codeHash
+}
+
+// GetCodeHistory returns the codes a contract ran with the heights they were set at
+func (k *Keeper) GetCodeHistory(ctx sdk.Context, addr sdk.AccAddress) types.CodeHistory {
+	return k.StateDB.WithContext(ctx).GetCodeHistory(addr)
+}
+
 func (k *Keeper) GetAllHostContractAddresses(ctx sdk.Context) []sdk.AccAddress {
 	return k.StateDB.WithContext(ctx).GetAllHotContractAddrs()
 }
+
+// SetEthCompatible switches code hashing and contract address derivation to the rules of Ethereum
+func (k *Keeper) SetEthCompatible(ethCompatible bool) {
+	k.StateDB.SetEthCompatible(ethCompatible)
+}
+
+// SetFork selects the release of Ethereum whose instruction set and gas rules the vm follows
+func (k *Keeper) SetFork(fork types.Fork) {
+	k.fork = fork
+}
+
+// Fork returns the release of Ethereum whose instruction set and gas rules the vm follows
+func (k Keeper) Fork() types.Fork {
+	return k.fork
+}
+
+// SetStorageRent makes the contracts pay the rent of their storage
+func (k *Keeper) SetStorageRent(storageRent bool) {
+	k.StateDB.SetStorageRent(storageRent)
+}
+
+// MigrateCodeHashes moves the code of every contract to its hash under the current code hashing
+func (k *Keeper) MigrateCodeHashes(ctx sdk.Context) (int, error) {
+	return types.NewStateDB(k.StateDB).WithContext(ctx).MigrateCodeHashes()
+}
+
+// MigrateStorageBytes counts the slots of every contract into its storage footprint
+func (k *Keeper) MigrateStorageBytes(ctx sdk.Context) int {
+	return types.NewStateDB(k.StateDB).WithContext(ctx).MigrateStorageBytes()
+}
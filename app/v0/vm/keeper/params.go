@@ -54,12 +54,94 @@ func (k Keeper) SetVMContractCreationGasParams(ctx sdk.Context, params types.VMC
 	k.paramstore.Set(ctx, types.KeyVMContractCreationGasParams, params)
 }
 
+// GetMinGasPrice return MinGasPrice from store, the default for a store written
+// before the param was introduced
+func (k Keeper) GetMinGasPrice(ctx sdk.Context) (res uint64) {
+	res = types.DefaultMinGasPrice
+	k.paramstore.GetIfExists(ctx, types.KeyMinGasPrice, &res)
+	return
+}
+
+// SetMinGasPrice save MinGasPrice to store
+func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice uint64) {
+	k.paramstore.Set(ctx, types.KeyMinGasPrice, minGasPrice)
+}
+
+// GetStakingPrecompileGasParams return StakingPrecompileGasParams from store, the default
+// for a store written before the param was introduced
+func (k Keeper) GetStakingPrecompileGasParams(ctx sdk.Context) (params types.StakingPrecompileGasParams) {
+	params = types.DefaultStakingPrecompileGasParams
+	k.paramstore.GetIfExists(ctx, types.KeyStakingPrecompileGasParams, &params)
+	return
+}
+
+// SetStakingPrecompileGasParams save StakingPrecompileGasParams to store
+func (k Keeper) SetStakingPrecompileGasParams(ctx sdk.Context, params types.StakingPrecompileGasParams) {
+	k.paramstore.Set(ctx, types.KeyStakingPrecompileGasParams, params)
+}
+
+// GetPrecompiles return the precompiled contract registry from store, the default
+// registry for a store written before the param was introduced
+func (k Keeper) GetPrecompiles(ctx sdk.Context) (precompiles []types.Precompile) {
+	if !k.paramstore.Has(ctx, types.KeyPrecompiles) {
+		return types.DefaultPrecompiles()
+	}
+	k.paramstore.Get(ctx, types.KeyPrecompiles, &precompiles)
+	return
+}
+
+// SetPrecompiles save the precompiled contract registry to store
+func (k Keeper) SetPrecompiles(ctx sdk.Context, precompiles []types.Precompile) {
+	k.paramstore.Set(ctx, types.KeyPrecompiles, precompiles)
+}
+
+// GetDeploymentMode return DeploymentMode from store, the open mode for a store
+// written before the param was introduced
+func (k Keeper) GetDeploymentMode(ctx sdk.Context) (res string) {
+	res = types.DeploymentModeOpen
+	k.paramstore.GetIfExists(ctx, types.KeyDeploymentMode, &res)
+	return
+}
+
+// SetDeploymentMode save DeploymentMode to store
+func (k Keeper) SetDeploymentMode(ctx sdk.Context, deploymentMode string) {
+	k.paramstore.Set(ctx, types.KeyDeploymentMode, deploymentMode)
+}
+
+// GetStorageRentRate return StorageRentRate from store, the default for a store
+// written before the param was introduced
+func (k Keeper) GetStorageRentRate(ctx sdk.Context) (res uint64) {
+	res = types.DefaultStorageRentRate
+	k.paramstore.GetIfExists(ctx, types.KeyStorageRentRate, &res)
+	return
+}
+
+// SetStorageRentRate save StorageRentRate to store
+func (k Keeper) SetStorageRentRate(ctx sdk.Context, storageRentRate uint64) {
+	k.paramstore.Set(ctx, types.KeyStorageRentRate, storageRentRate)
+}
+
+// RecommendedGasPrice returns the lowest gas price a vm tx is accepted with, the
+// highest of the min gas price of the vm and the gas price threshold of auth
+func (k Keeper) RecommendedGasPrice(ctx sdk.Context) uint64 {
+	gasPrice := k.GetMinGasPrice(ctx)
+	if threshold := k.ak.GetParams(ctx).GasPriceThreshold; threshold > gasPrice {
+		gasPrice = threshold
+	}
+	return gasPrice
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	return types.NewParams(
 		k.GetMaxCodeSize(ctx),
 		k.GetMaxCallCreateDepth(ctx),
 		k.GetVMOpGasParams(ctx),
 		k.GetVMContractCreationGasParams(ctx),
+		k.GetMinGasPrice(ctx),
+		k.GetStakingPrecompileGasParams(ctx),
+		k.GetPrecompiles(ctx),
+		k.GetDeploymentMode(ctx),
+		k.GetStorageRentRate(ctx),
 	)
 }
 
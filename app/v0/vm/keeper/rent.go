@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// GetContractRent returns the storage footprint and rent deposit of a contract
+func (k Keeper) GetContractRent(ctx sdk.Context, addr sdk.AccAddress) types.ContractRent {
+	return k.StateDB.WithContext(ctx).GetContractRent(addr)
+}
+
+// StorageRentRate returns the rent the contracts are charged per storage byte per block,
+// none before the storage rent version
+func (k Keeper) StorageRentRate(ctx sdk.Context) uint64 {
+	if !k.StateDB.StorageRent() {
+		return 0
+	}
+	return k.GetStorageRentRate(ctx)
+}
+
+// IsFrozen returns whether a contract rejects the calls as its rent deposit ran out or does
+// not cover the rent it owes since it was last charged
+func (k Keeper) IsFrozen(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetContractRent(ctx, addr).IsFrozenAt(k.StorageRentRate(ctx), ctx.BlockHeight())
+}
+
+// DepositRent moves amount from an account to the rent deposit of a contract held by the
+// vm module account. The rent owed is charged first, a frozen contract is unfrozen once
+// its deposit covers a block of rent
+func (k Keeper) DepositRent(ctx sdk.Context, from, contract sdk.AccAddress, amount sdk.Coin) error {
+	err := k.sk.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	k.ChargeStorageRent(ctx, contract)
+
+	stateDB := k.StateDB.WithContext(ctx)
+	rent := stateDB.GetContractRent(contract)
+	rent.Deposit = rent.Deposit.Add(amount.Amount)
+	if rent.Frozen && rent.Deposit.GTE(rent.Due(k.StorageRentRate(ctx))) {
+		rent.Frozen = false
+	}
+	stateDB.SetContractRent(contract, rent)
+
+	return nil
+}
+
+// ChargeStorageRent deducts the rent a contract owes since it was last charged from its
+// deposit and pays it to the fee collector. A contract is charged when a msg calls it or
+// deposits into it, for the footprint and at the rate of the time of the charge. A
+// contract whose deposit does not cover the rent pays what is left and is frozen, a
+// frozen contract is not charged for the blocks it stays frozen
+func (k Keeper) ChargeStorageRent(ctx sdk.Context, addr sdk.AccAddress) {
+	if !k.StateDB.StorageRent() {
+		return
+	}
+
+	stateDB := k.StateDB.WithContext(ctx)
+	rent := stateDB.GetContractRent(addr)
+	if rent.StorageBytes == 0 || ctx.BlockHeight() <= rent.ChargedHeight {
+		return
+	}
+
+	owed := rent.Owed(k.GetStorageRentRate(ctx), ctx.BlockHeight())
+	collected := owed
+	if rent.Deposit.GTE(owed) {
+		rent.Deposit = rent.Deposit.Sub(owed)
+	} else {
+		collected = rent.Deposit
+		rent.Deposit = sdk.ZeroInt()
+		rent.Frozen = true
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractFrozen,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		))
+		k.Logger(ctx).Info("contract frozen, its storage rent deposit ran out", "contract", addr.String())
+	}
+	rent.ChargedHeight = ctx.BlockHeight()
+	stateDB.SetContractRent(addr, rent)
+
+	if collected.IsPositive() {
+		err := k.sk.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(sdk.NativeTokenName, collected)))
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/bank"
 	distr "github.com/Dipper-Labs/Dipper-Protocol/app/v0/distribution"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/mint"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/params"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/slashing"
@@ -46,6 +47,8 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		protocol.TokenModuleName:  {supply.Minter, supply.Burner},
+		types.ModuleName:          nil,
 	}
 )
 
@@ -62,6 +65,24 @@ func MakeTestCodec() *codec.Codec {
 }
 
 func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context, auth.AccountKeeper, Keeper, supply.Keeper) {
+	return createTestInput(t, isCheckTx, initPower, func(ctx sdk.Context, k Keeper) {
+		k.SetParams(ctx, types.DefaultParams())
+	})
+}
+
+// CreateTestInputWithLegacyParams returns a test input whose vm param store only holds
+// the params of the first release, as on a chain upgraded from it
+func CreateTestInputWithLegacyParams(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context, auth.AccountKeeper, Keeper, supply.Keeper) {
+	return createTestInput(t, isCheckTx, initPower, func(ctx sdk.Context, k Keeper) {
+		params := types.DefaultParams()
+		k.SetMaxCodeSize(ctx, params.MaxCodeSize)
+		k.SetMaxCallCreateDepth(ctx, params.MaxCallCreateDepth)
+		k.SetVMOpGasParams(ctx, params.VMOpGasParams)
+		k.SetVMContractCreationGasParams(ctx, params.VMContractCreationGasParams)
+	})
+}
+
+func createTestInput(t *testing.T, isCheckTx bool, initPower int64, setParams func(sdk.Context, Keeper)) (sdk.Context, auth.AccountKeeper, Keeper, supply.Keeper) {
 	keys := sdk.NewKVStoreKeys(
 		protocol.MainStoreKey,
 		auth.StoreKey,
@@ -72,6 +93,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		distr.StoreKey,
 		slashing.StoreKey,
 		gov.StoreKey,
+		guardian.StoreKey,
 		params.StoreKey,
 		types.StoreKey,
 	)
@@ -118,6 +140,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		paramsKeeper.Subspace(bank.DefaultParamspace),
 		blacklistedAddrs,
 	)
+	bankKeeper.SetSendEnabled(ctx, true)
 
 	supplyKeeper := supply.NewKeeper(cdc, keys[supply.StoreKey], accountKeeper, bankKeeper, maccPerms)
 
@@ -127,13 +150,31 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
 
+	stakingKeeper := staking.NewKeeper(cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey], supplyKeeper, paramsKeeper.Subspace(staking.DefaultParamspace))
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+
+	distrKeeper := distr.NewKeeper(cdc, keys[distr.StoreKey], paramsKeeper.Subspace(distr.DefaultParamspace), stakingKeeper,
+		supplyKeeper, auth.FeeCollectorName, blacklistedAddrs)
+	distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
+	distrKeeper.SetCommunityTax(ctx, sdk.NewDecWithPrec(2, 2))
+	distrKeeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(1, 2))
+	distrKeeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(4, 2))
+	stakingKeeper.SetHooks(distrKeeper.Hooks())
+
+	guardianKeeper := guardian.NewKeeper(cdc, keys[guardian.StoreKey])
+
 	keeper := NewKeeper(
 		cdc,
 		keys[types.StoreKey],
 		paramsKeeper.Subspace(DefaultParamspace),
 		accountKeeper,
+		bankKeeper,
+		supplyKeeper,
+		stakingKeeper,
+		distrKeeper,
+		guardianKeeper,
 	)
-	keeper.SetParams(ctx, types.DefaultParams())
+	setParams(ctx, keeper)
 
 	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
 
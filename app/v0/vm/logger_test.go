@@ -41,7 +41,12 @@ func TestStoreCapture(t *testing.T) {
 		types.ModuleCdc,
 		sdk.NewKVStoreKey(StoreKey),
 		paramsKeeper.Subspace(bank.DefaultParamspace),
-		accountKeeper)
+		accountKeeper,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil)
 
 	var (
 		env      = NewEVM(Context{}, vmKeeper.StateDB, Config{})
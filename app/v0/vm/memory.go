@@ -52,6 +52,14 @@ func (m *Memory) GetCopy(offset, size int64) (cpy []byte) {
 	return
 }
 
+// Copy copies size bytes from src to dst, the regions may overlap
+func (m *Memory) Copy(dst, src, size uint64) {
+	if size == 0 {
+		return
+	}
+	copy(m.store[dst:], m.store[src:src+size])
+}
+
 // Resize resizes the memory to size
 func (m *Memory) Resize(size uint64) {
 	if uint64(m.Len()) < size {
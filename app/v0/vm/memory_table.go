@@ -20,6 +20,15 @@ func memoryExtCodeCopy(stack *Stack) (uint64, bool) {
 	return calcMemSize64(stack.Back(1), stack.Back(3))
 }
 
+// memoryMcopy fits both the source and the destination of MCOPY
+func memoryMcopy(stack *Stack) (uint64, bool) {
+	offset := stack.Back(0)
+	if stack.Back(1).Cmp(offset) > 0 {
+		offset = stack.Back(1)
+	}
+	return calcMemSize64(offset, stack.Back(2))
+}
+
 func memoryMLoad(stack *Stack) (uint64, bool) {
 	return calcMemSize64WithUint(stack.Back(0), 32)
 }
@@ -85,6 +85,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 
 	am.keeper.StateDB.WithContext(ctx).ImportState(genesisState)
 
+	for _, deployer := range genesisState.Deployers {
+		am.keeper.AddDeployer(ctx, deployer)
+	}
+
 	return nil
 }
 
@@ -92,6 +96,7 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	vmState := am.keeper.StateDB.WithContext(ctx).ExportState()
 	vmState.Params = am.keeper.GetParams(ctx)
+	vmState.Deployers = am.keeper.GetDeployers(ctx)
 	return ModuleCdc.MustMarshalJSON(vmState)
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
@@ -137,3 +138,18 @@ func (st *VMTestSuite) TestContractExportImport() {
 func TestStart(t *testing.T) {
 	suite.Run(t, new(VMTestSuite))
 }
+
+func TestLegacyParams(t *testing.T) {
+	ctx, _, vmKeeper, _ := keeper.CreateTestInputWithLegacyParams(t, false, 1000)
+	defaults := types.DefaultParams()
+
+	// the params introduced after the first release read as their defaults
+	require.Equal(t, defaults.StakingPrecompileGasParams, vmKeeper.GetStakingPrecompileGasParams(ctx))
+	require.Equal(t, defaults.Precompiles, vmKeeper.GetPrecompiles(ctx))
+	require.Equal(t, defaults.DeploymentMode, vmKeeper.GetDeploymentMode(ctx))
+	require.Equal(t, defaults.StorageRentRate, vmKeeper.GetStorageRentRate(ctx))
+	require.Equal(t, defaults, vmKeeper.GetParams(ctx))
+
+	// the first end of block of an upgraded chain does not panic
+	require.NotPanics(t, func() { vm.EndBlocker(ctx, vmKeeper) })
+}
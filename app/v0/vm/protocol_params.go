@@ -50,6 +50,15 @@ const (
 	ExtcodeHashGas  uint64 = 700  // Cost of EXTCODEHASH after EIP 1884 (part in Istanbul)
 	SelfdestructGas uint64 = 5000 // Cost of SELFDESTRUCT post EIP 150 (Tangerine)
 
+	// Accesses to accounts and storage after EIP 2929 (Berlin), the warm cost is the
+	// constant gas of the opcodes and the rest of the cold cost is charged on the first access
+	ColdAccountAccessCostEIP2929 uint64 = 2600 // Cost of the first access to an account in a tx
+	ColdSloadCostEIP2929         uint64 = 2100 // Cost of the first access to a storage slot in a tx
+	WarmStorageReadCostEIP2929   uint64 = 100  // Cost of the next accesses to an account or a storage slot
+
+	TxAccessListAddressGas    uint64 = 2400 // Per address of the access list of a tx (EIP 2930)
+	TxAccessListStorageKeyGas uint64 = 1900 // Per storage key of the access list of a tx (EIP 2930)
+
 	// EXP has a dynamic portion depending on the size of the exponent
 	ExpByte uint64 = 50 // was raised to 50 during Eip158 (Spurious Dragon)
 
@@ -79,4 +88,10 @@ const (
 	Bn256ScalarMulGas       uint64 = 6000  // Gas needed for an elliptic curve scalar multiplication
 	Bn256PairingBaseGas     uint64 = 45000 // Base price for an elliptic curve pairing check
 	Bn256PairingPerPointGas uint64 = 34000 // Per-point price for an elliptic curve pairing check
+
+	// Token contract gas prices
+
+	TokenQueryGas    uint64 = 1000  // Price of a call reading the state of a token, e.g. balanceOf
+	TokenApproveGas  uint64 = 22000 // Price of an approve, it stores the allowance
+	TokenTransferGas uint64 = 30000 // Price of a transfer or transferFrom, it sends the coins through the bank
 )
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client"
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
@@ -15,6 +16,14 @@ import (
 
 const (
 	GasReservedForOutOfVm = 10000
+
+	// DefaultLogsLimit is the page size of a log filter query without limit
+	DefaultLogsLimit = 100
+	// MaxLogsBlockRange is the widest block range a log filter query scans
+	MaxLogsBlockRange = 10000
+	// MaxLogsResults is the most logs a log filter query collects, the logs of its page
+	// and of the pages before it
+	MaxLogsResults = 10000
 )
 
 func NewQuerier(k keeper.Keeper) sdk.Querier {
@@ -30,6 +39,20 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 			return queryStorage(ctx, path, k)
 		case types.QueryTxLogs:
 			return queryTxLogs(ctx, path, k)
+		case types.QueryFilterLogs:
+			return queryFilterLogs(ctx, req, k)
+		case types.QueryReceipt:
+			return queryReceipt(ctx, path, k)
+		case types.QueryContractMeta:
+			return queryContractMeta(ctx, path, k)
+		case types.QueryDeployers:
+			return queryDeployers(ctx, k)
+		case types.QueryCodeHistory:
+			return queryCodeHistory(ctx, path, k)
+		case types.QueryContractRent:
+			return queryContractRent(ctx, path, k)
+		case types.QueryTrace:
+			return traceTx(ctx, req, k)
 		case types.EstimateGas, types.QueryCall:
 			return simulateStateTransition(ctx, req, k)
 		default:
@@ -95,20 +118,254 @@ func queryTxLogs(ctx sdk.Context, path []string, keeper keeper.Keeper) ([]byte,
 	return res, nil
 }
 
+// queryFilterLogs returns a page of the logs of a block range matching the addresses and
+// topics, the logs are ordered by block and log index
+func queryFilterLogs(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	var params types.QueryFilterLogsParams
+	if err := codec.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if len(params.Topics) > 4 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at most 4 topic positions, got %d", len(params.Topics))
+	}
+
+	toBlock := params.ToBlock
+	if toBlock <= 0 || toBlock > ctx.BlockHeight() {
+		toBlock = ctx.BlockHeight()
+	}
+	fromBlock := params.FromBlock
+	if fromBlock <= 0 {
+		fromBlock = toBlock
+	}
+
+	if toBlock-fromBlock >= MaxLogsBlockRange {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d blocks, got %d", MaxLogsBlockRange, toBlock-fromBlock+1)
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultLogsLimit
+	}
+	if params.Page > MaxLogsResults/limit {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d logs, got page %d of %d logs", MaxLogsResults, params.Page, limit)
+	}
+
+	logs := []*types.Log{}
+	if fromBlock <= toBlock && params.Page > 0 {
+		filter := types.NewLogFilter(uint64(fromBlock), uint64(toBlock), params.Addresses, params.Topics)
+		logs = keeper.FilterLogs(ctx, filter, params.Page*limit)
+	}
+
+	start, end := client.Paginate(len(logs), params.Page, limit, DefaultLogsLimit)
+	if start < 0 || end < 0 {
+		logs = []*types.Log{}
+	} else {
+		logs = logs[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, types.QueryLogsResult{Logs: logs})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryReceipt(ctx sdk.Context, path []string, keeper keeper.Keeper) ([]byte, error) {
+	txHash := sdk.HexToHash(path[1])
+	receipt := keeper.GetReceipt(ctx, txHash)
+	if receipt == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNoReceipt, "tx %s", txHash.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, receipt)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryContractMeta(ctx sdk.Context, path []string, keeper keeper.Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	meta := keeper.GetContractMeta(ctx, addr)
+	if meta == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNoContractMeta, "contract %s", addr.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, meta)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryCodeHistory returns the codes a contract ran with the heights they were set at
+func queryCodeHistory(ctx sdk.Context, path []string, keeper keeper.Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	history := keeper.GetCodeHistory(ctx, addr)
+	if history == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNoContractMeta, "contract %s", addr.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryContractRent returns the storage footprint and rent deposit of a contract
+func queryContractRent(ctx sdk.Context, path []string, keeper keeper.Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, keeper.GetContractRent(ctx, addr))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryDeployers returns the accounts of the deployer allow-list, the list is only enforced
+// in the allow_list deployment mode
+func queryDeployers(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	deployers := types.QueryDeployersResult(keeper.GetDeployers(ctx))
+	if deployers == nil {
+		deployers = types.QueryDeployersResult{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, deployers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// traceTx replays the txs of a block which come before the traced tx and then runs the
+// traced tx under the tracer selected by the config. The query must be run at the
+// height before the block. Only the vm msgs and the sequences of the signers are
+// replayed, fees are not deducted.
+func traceTx(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, error) {
+	var params types.QueryTraceParams
+	if err := codec.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if len(params.Tx.Msgs) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tx %s must carry exactly one vm msg, got %d", params.Tx.TxHash.String(), len(params.Tx.Msgs))
+	}
+	if params.Config.Tracer != "" && params.Config.Tracer != types.CallTracerName {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown tracer %s", params.Config.Tracer)
+	}
+
+	ctx = ctx.WithBlockHeader(params.Header)
+
+	for _, tx := range params.Txs {
+		nonces := incrementSequences(ctx, k, tx.Signers)
+
+		// the writes of all the msgs of a tx are discarded when one of them fails
+		cacheCtx, write := withTraceTx(ctx, tx, nonces).CacheContext()
+		failed := false
+		for _, msg := range tx.Msgs {
+			if _, err := TraceStateTransition(cacheCtx, msg, tx.TxHash, k, nil); err != nil {
+				failed = true
+				break
+			}
+		}
+		if !failed {
+			write()
+		}
+	}
+
+	nonces := incrementSequences(ctx, k, params.Tx.Signers)
+	msg := params.Tx.Msgs[0]
+	ctx = withTraceTx(ctx, params.Tx, nonces)
+
+	var res interface{}
+	if params.Config.Tracer == types.CallTracerName {
+		tracer := NewCallTracer()
+		_, _ = TraceStateTransition(ctx, msg, params.Tx.TxHash, k, tracer)
+		res = tracer.CallFrame()
+	} else {
+		logger := NewStructLogger(&LogConfig{
+			DisableMemory:  params.Config.DisableMemory,
+			DisableStack:   params.Config.DisableStack,
+			DisableStorage: params.Config.DisableStorage,
+			Limit:          params.Config.Limit,
+		})
+		result, vmerr := TraceStateTransition(ctx, msg, params.Tx.TxHash, k, logger)
+		res = types.ExecutionResult{
+			Gas:         result.GasUsed,
+			Failed:      vmerr != nil,
+			ReturnValue: hex.EncodeToString(result.Data),
+			StructLogs:  FormatLogs(logger.StructLogs()),
+		}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// withTraceTx sets the gas price and the signed nonces of a traced tx on the context as
+// the ante handler does, the txs without gas price run at the min gas price
+func withTraceTx(ctx sdk.Context, tx types.TraceTx, nonces types.SignedNonces) sdk.Context {
+	ctx = types.WithSignedNonces(ctx, nonces)
+	if tx.GasPrice == (sdk.Int{}) {
+		return ctx
+	}
+	return types.WithGasPrice(ctx, tx.GasPrice)
+}
+
+// incrementSequences increments the sequences of the signers of a tx the same way the ante
+// handler does and returns the sequences they signed it with
+func incrementSequences(ctx sdk.Context, k keeper.Keeper, signers []sdk.AccAddress) types.SignedNonces {
+	nonces := make(types.SignedNonces, len(signers))
+	stateDB := types.NewStateDB(k.StateDB).WithContext(ctx)
+	for _, signer := range signers {
+		nonces[signer.String()] = stateDB.GetNonce(signer)
+		stateDB.SetNonce(signer, stateDB.GetNonce(signer)+1)
+	}
+	stateDB.Finalise(false)
+	return nonces
+}
+
 func simulateStateTransition(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, error) {
 	var msg types.MsgContract
 	codec.Cdc.UnmarshalJSON(req.Data, &msg)
 
-	_, result, err := DoStateTransition(ctx, msg, k, true)
+	// the sender runs with the sequence it has once the ante handler passed and the one it
+	// signed with, so a created contract gets the address it is delivered at
+	ctx = types.WithSignedNonces(ctx, incrementSequences(ctx, k, []sdk.AccAddress{msg.From}))
 
-	if err == nil {
-		bRes := types.SimulationResult{Gas: result.GasUsed, Res: hex.EncodeToString(result.Data)}
-		res, err := codec.MarshalJSONIndent(k.Cdc, bRes)
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-		}
-		return res, nil
+	_, result, err := DoStateTransition(ctx, msg, k, true)
+	if err != nil {
+		// the vm error carries the failing contract and the revert reason
+		return nil, err
 	}
 
-	return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "StateTransition faileds")
+	bRes := types.SimulationResult{Gas: result.GasUsed, GasPrice: k.RecommendedGasPrice(ctx), Res: hex.EncodeToString(result.Data)}
+	res, err := codec.MarshalJSONIndent(k.Cdc, bRes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
 }
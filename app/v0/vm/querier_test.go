@@ -0,0 +1,159 @@
+package vm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestQueryTrace(t *testing.T) {
+	ctx, accountKeeper, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	querier := NewQuerier(vmKeeper)
+
+	bc, err := ioutil.ReadFile("./testdata/opCreate/a.bc")
+	require.NoError(t, err)
+	code := sdk.FromHex(strings.TrimSpace(string(bc)))
+	acc := accountKeeper.GetAccount(ctx, keep.Addrs[0])
+	// the sequence is incremented by the ante handler before the contract is created
+	contractAddr := CreateAddress(acc.GetAddress(), acc.GetSequence()+1)
+
+	// the contract is created earlier in the block, bf335e62 creates two contracts
+	params := types.QueryTraceParams{
+		Header: abci.Header{Height: 2, ChainID: ctx.ChainID()},
+		Txs: []types.TraceTx{{
+			TxHash:  sdk.BytesToHash([]byte("create")),
+			Signers: []sdk.AccAddress{acc.GetAddress()},
+			Msgs:    []types.MsgContract{types.NewMsgContract(acc.GetAddress(), nil, code, sdk.NewInt64Coin(sdk.NativeTokenName, 0))},
+		}},
+		Tx: types.TraceTx{
+			TxHash:  sdk.BytesToHash([]byte("call")),
+			Signers: []sdk.AccAddress{acc.GetAddress()},
+			Msgs:    []types.MsgContract{types.NewMsgContract(acc.GetAddress(), contractAddr, sdk.FromHex("bf335e62"), sdk.NewInt64Coin(sdk.NativeTokenName, 0))},
+		},
+	}
+
+	trace := func(params types.QueryTraceParams) ([]byte, error) {
+		cacheCtx, _ := ctx.CacheContext()
+		return querier(cacheCtx, []string{types.QueryTrace}, abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(params)})
+	}
+
+	// struct logger
+	bz, err := trace(params)
+	require.NoError(t, err)
+	var result types.ExecutionResult
+	require.NoError(t, json.Unmarshal(bz, &result))
+	require.False(t, result.Failed)
+	require.True(t, result.Gas > 0)
+	require.NotEmpty(t, result.StructLogs)
+	require.Equal(t, "PUSH1", result.StructLogs[0].Op)
+	require.Equal(t, 1, result.StructLogs[0].Depth)
+	require.NotNil(t, result.StructLogs[0].Stack)
+
+	params.Config = types.TraceConfig{DisableStack: true, Limit: 10}
+	bz, err = trace(params)
+	require.NoError(t, err)
+	var limited types.ExecutionResult
+	require.NoError(t, json.Unmarshal(bz, &limited))
+	require.Len(t, limited.StructLogs, 10)
+	require.Nil(t, limited.StructLogs[0].Stack)
+
+	// call tracer
+	params.Config = types.TraceConfig{Tracer: types.CallTracerName}
+	bz, err = trace(params)
+	require.NoError(t, err)
+	var frame types.CallFrame
+	require.NoError(t, json.Unmarshal(bz, &frame))
+	require.Equal(t, "CALL", frame.Type)
+	require.Equal(t, acc.GetAddress(), frame.From)
+	require.Equal(t, contractAddr, frame.To)
+	require.Empty(t, frame.Error)
+	require.True(t, frame.GasUsed > 0)
+	require.Len(t, frame.Calls, 2)
+	for _, call := range frame.Calls {
+		require.Equal(t, "CREATE", call.Type)
+		require.Equal(t, contractAddr, call.From)
+		require.False(t, call.To.Empty())
+		require.Empty(t, call.Error)
+		require.True(t, call.GasUsed > 0)
+	}
+	require.NotEqual(t, frame.Calls[0].To, frame.Calls[1].To)
+
+	// without the creation the call runs against an empty account
+	params.Txs = nil
+	bz, err = trace(params)
+	require.NoError(t, err)
+	var empty types.CallFrame
+	require.NoError(t, json.Unmarshal(bz, &empty))
+	require.Empty(t, empty.Calls)
+
+	params.Config = types.TraceConfig{Tracer: "jsTracer"}
+	_, err = trace(params)
+	require.Error(t, err)
+}
+
+func TestQueryFilterLogs(t *testing.T) {
+	ctx, _, vmKeeper, _ := keep.CreateTestInput(t, false, 1000000)
+	querier := NewQuerier(vmKeeper)
+
+	contract := sdk.AccAddress([]byte("contract____________"))
+	transfer := sdk.HexToHash("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	// two logs per block from height 1 to 5
+	for height := int64(1); height <= 5; height++ {
+		stateDB := vmKeeper.StateDB.WithContext(ctx.WithBlockHeight(height)).WithTxHash([]byte{byte(height)})
+		stateDB.AddLog(&types.Log{Address: contract, Topics: []sdk.Hash{transfer}, BlockNumber: uint64(height)})
+		stateDB.AddLog(&types.Log{Address: contract, Topics: []sdk.Hash{transfer}, BlockNumber: uint64(height)})
+		stateDB.Finalise(true)
+	}
+	ctx = ctx.WithBlockHeight(5)
+
+	filterLogs := func(params types.QueryFilterLogsParams) []*types.Log {
+		bz, err := querier(ctx, []string{types.QueryFilterLogs}, abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(params)})
+		require.NoError(t, err)
+		var res types.QueryLogsResult
+		vmKeeper.Cdc.MustUnmarshalJSON(bz, &res)
+		return res.Logs
+	}
+
+	addrs := []sdk.AccAddress{contract}
+	topics := [][]sdk.Hash{{transfer}}
+	require.Len(t, filterLogs(types.NewQueryFilterLogsParams(1, 5, addrs, topics, 1, 0)), 10)
+	require.Len(t, filterLogs(types.NewQueryFilterLogsParams(2, 3, addrs, topics, 1, 0)), 4)
+
+	// the latest block is used for the missing bounds
+	logs := filterLogs(types.NewQueryFilterLogsParams(0, 0, nil, nil, 1, 0))
+	require.Len(t, logs, 2)
+	require.Equal(t, uint64(5), logs[0].BlockNumber)
+	require.Len(t, filterLogs(types.NewQueryFilterLogsParams(4, 0, nil, nil, 1, 0)), 4)
+
+	// pages are ordered by block and log index
+	page1 := filterLogs(types.NewQueryFilterLogsParams(1, 5, addrs, nil, 1, 4))
+	page3 := filterLogs(types.NewQueryFilterLogsParams(1, 5, addrs, nil, 3, 4))
+	require.Len(t, page1, 4)
+	require.Len(t, page3, 2)
+	require.Equal(t, uint64(1), page1[0].BlockNumber)
+	require.True(t, page1[0].Index < page1[1].Index)
+	require.Equal(t, uint64(5), page3[1].BlockNumber)
+	require.Empty(t, filterLogs(types.NewQueryFilterLogsParams(1, 5, addrs, nil, 4, 4)))
+
+	_, err := querier(ctx, []string{types.QueryFilterLogs}, abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(
+		types.NewQueryFilterLogsParams(1, 5, nil, make([][]sdk.Hash, 5), 1, 0))})
+	require.Error(t, err)
+
+	// the block range and the logs collected for a page are bounded
+	_, err = querier(ctx.WithBlockHeight(MaxLogsBlockRange+1), []string{types.QueryFilterLogs}, abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(
+		types.NewQueryFilterLogsParams(1, MaxLogsBlockRange+1, nil, nil, 1, 0))})
+	require.Error(t, err)
+	_, err = querier(ctx, []string{types.QueryFilterLogs}, abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(
+		types.NewQueryFilterLogsParams(1, 5, nil, nil, MaxLogsResults/4+1, 4))})
+	require.Error(t, err)
+}
@@ -0,0 +1,296 @@
+package vm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	stakingtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// StakingContractABI is the interface of the staking contract, the caller is the delegator
+const StakingContractABI = `[
+{"type":"function","name":"delegate","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"undelegate","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"completionTime","type":"uint256"}]},
+{"type":"function","name":"redelegate","stateMutability":"nonpayable","inputs":[{"name":"validatorSrc","type":"address"},{"name":"validatorDst","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"completionTime","type":"uint256"}]},
+{"type":"function","name":"delegation","stateMutability":"view","inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"address"}],"outputs":[{"name":"shares","type":"uint256"},{"name":"amount","type":"uint256"}]},
+{"type":"event","name":"Delegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]},
+{"type":"event","name":"Undelegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"completionTime","type":"uint256","indexed":false}]},
+{"type":"event","name":"Redelegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validatorSrc","type":"address","indexed":true},{"name":"validatorDst","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"completionTime","type":"uint256","indexed":false}]}
+]`
+
+// DistributionContractABI is the interface of the distribution contract, the caller is the delegator
+const DistributionContractABI = `[
+{"type":"function","name":"withdrawRewards","stateMutability":"nonpayable","inputs":[{"name":"validator","type":"address"}],"outputs":[{"name":"amount","type":"uint256"}]},
+{"type":"event","name":"WithdrawRewards","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"validator","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]}
+]`
+
+var (
+	stakingABI      abi.ABI
+	distributionABI abi.ABI
+)
+
+func init() {
+	var err error
+	if stakingABI, err = abi.JSON(strings.NewReader(StakingContractABI)); err != nil {
+		panic(err)
+	}
+	if distributionABI, err = abi.JSON(strings.NewReader(DistributionContractABI)); err != nil {
+		panic(err)
+	}
+}
+
+// stakingContract delegates, undelegates and redelegates the coins of its caller through
+// the staking keeper. The writes go through CommitStateDB.NativeCall so that they are
+// dropped with the call frame when it reverts.
+type stakingContract struct {
+	k   Keeper
+	gas types.StakingPrecompileGasParams
+}
+
+func (c *stakingContract) RequiredGas(input []byte) uint64 {
+	if len(input) >= 4 {
+		if method, err := stakingABI.MethodById(input[:4]); err == nil {
+			switch method.Name {
+			case "delegate":
+				return c.gas.Delegate
+			case "undelegate":
+				return c.gas.Undelegate
+			case "redelegate":
+				return c.gas.Redelegate
+			}
+		}
+	}
+	return c.gas.Query
+}
+
+func (c *stakingContract) Run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
+	method, args, err := unpackPrecompileInput(stakingABI, contract, input)
+	if err != nil {
+		return revertWith(err.Error())
+	}
+
+	sk := c.k.StakingKeeper()
+	delegator := contract.Caller()
+
+	if method.Name == "delegation" {
+		shares, amount := big.NewInt(0), big.NewInt(0)
+		ctx := evm.StateDB.Context()
+		valAddr := sdk.ValAddress(addressArg(args[1]))
+		if delegation, found := sk.GetDelegation(ctx, addressArg(args[0]), valAddr); found {
+			shares = new(big.Int).Set(delegation.Shares.Int)
+			if validator, found := sk.GetValidator(ctx, valAddr); found {
+				amount = validator.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+			}
+		}
+		return method.Outputs.Pack(shares, amount)
+	}
+
+	if readOnly {
+		return nil, ErrWriteProtection
+	}
+
+	switch method.Name {
+	case "delegate":
+		valAddr, amount := sdk.ValAddress(addressArg(args[0])), args[1].(*big.Int)
+		if reason := validateStakingAmount(amount); reason != "" {
+			return revertWith(reason)
+		}
+
+		err = evm.StateDB.NativeCall(func(ctx sdk.Context) error {
+			validator, found := sk.GetValidator(ctx, valAddr)
+			if !found {
+				return stakingtypes.ErrNoValidatorFound
+			}
+			if _, err := sk.Delegate(ctx, delegator, sdk.NewIntFromBigInt(amount), sdk.Unbonded, validator, true); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				stakingtypes.EventTypeDelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, delegator.String()),
+			))
+			return nil
+		})
+		if err != nil {
+			return revertWith(err.Error())
+		}
+
+		addPrecompileLog(evm, contract, stakingABI.Events["Delegate"], []sdk.AccAddress{delegator, sdk.AccAddress(valAddr)}, amount)
+		return method.Outputs.Pack(true)
+
+	case "undelegate":
+		valAddr, amount := sdk.ValAddress(addressArg(args[0])), args[1].(*big.Int)
+		if reason := validateStakingAmount(amount); reason != "" {
+			return revertWith(reason)
+		}
+
+		var completionTime time.Time
+		err = evm.StateDB.NativeCall(func(ctx sdk.Context) error {
+			shares, err := sk.ValidateUnbondAmount(ctx, delegator, valAddr, sdk.NewIntFromBigInt(amount))
+			if err != nil {
+				return err
+			}
+			if completionTime, err = sk.Undelegate(ctx, delegator, valAddr, shares); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				stakingtypes.EventTypeUnbond,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+				sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, delegator.String()),
+			))
+			return nil
+		})
+		if err != nil {
+			return revertWith(err.Error())
+		}
+
+		completion := big.NewInt(completionTime.Unix())
+		addPrecompileLog(evm, contract, stakingABI.Events["Undelegate"], []sdk.AccAddress{delegator, sdk.AccAddress(valAddr)}, amount, completion)
+		return method.Outputs.Pack(completion)
+
+	case "redelegate":
+		srcAddr, dstAddr, amount := sdk.ValAddress(addressArg(args[0])), sdk.ValAddress(addressArg(args[1])), args[2].(*big.Int)
+		if reason := validateStakingAmount(amount); reason != "" {
+			return revertWith(reason)
+		}
+
+		var completionTime time.Time
+		err = evm.StateDB.NativeCall(func(ctx sdk.Context) error {
+			shares, err := sk.ValidateUnbondAmount(ctx, delegator, srcAddr, sdk.NewIntFromBigInt(amount))
+			if err != nil {
+				return err
+			}
+			if completionTime, err = sk.BeginRedelegation(ctx, delegator, srcAddr, dstAddr, shares); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				stakingtypes.EventTypeRedelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, srcAddr.String()),
+				sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, dstAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+				sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, delegator.String()),
+			))
+			return nil
+		})
+		if err != nil {
+			return revertWith(err.Error())
+		}
+
+		completion := big.NewInt(completionTime.Unix())
+		addPrecompileLog(evm, contract, stakingABI.Events["Redelegate"],
+			[]sdk.AccAddress{delegator, sdk.AccAddress(srcAddr), sdk.AccAddress(dstAddr)}, amount, completion)
+		return method.Outputs.Pack(completion)
+	}
+
+	return revertWith("unknown method")
+}
+
+// distributionContract withdraws the delegation rewards of its caller through the
+// distribution keeper
+type distributionContract struct {
+	k   Keeper
+	gas types.StakingPrecompileGasParams
+}
+
+func (c *distributionContract) RequiredGas(input []byte) uint64 {
+	return c.gas.WithdrawRewards
+}
+
+func (c *distributionContract) Run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
+	method, args, err := unpackPrecompileInput(distributionABI, contract, input)
+	if err != nil {
+		return revertWith(err.Error())
+	}
+
+	if readOnly {
+		return nil, ErrWriteProtection
+	}
+
+	// withdrawRewards is the only method
+	delegator, valAddr := contract.Caller(), sdk.ValAddress(addressArg(args[0]))
+
+	var rewards sdk.Coins
+	err = evm.StateDB.NativeCall(func(ctx sdk.Context) (err error) {
+		rewards, err = c.k.DistributionKeeper().WithdrawDelegationRewards(ctx, delegator, valAddr)
+		return err
+	})
+	if err != nil {
+		return revertWith(err.Error())
+	}
+
+	amount := rewards.AmountOf(sdk.NativeTokenName).BigInt()
+	addPrecompileLog(evm, contract, distributionABI.Events["WithdrawRewards"], []sdk.AccAddress{delegator, sdk.AccAddress(valAddr)}, amount)
+	return method.Outputs.Pack(amount)
+}
+
+// unpackPrecompileInput checks that a stateful precompiled contract, acting on behalf of
+// its caller, is called directly and without value, and unpacks the arguments of the call
+func unpackPrecompileInput(contractABI abi.ABI, contract *Contract, input []byte) (*abi.Method, []interface{}, error) {
+	if !contract.Address().Equals(*contract.CodeAddr) {
+		return nil, nil, fmt.Errorf("precompiled contract can not be delegate called")
+	}
+	if contract.Value().Sign() != 0 {
+		return nil, nil, fmt.Errorf("precompiled contract is not payable")
+	}
+	if len(input) < 4 {
+		return nil, nil, fmt.Errorf("precompiled contract has no fallback")
+	}
+
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown method")
+	}
+	args, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid arguments of %s: %s", method.Name, err)
+	}
+
+	return method, args, nil
+}
+
+// validateStakingAmount returns the revert reason of an invalid amount to stake
+func validateStakingAmount(amount *big.Int) string {
+	if amount.Sign() == 0 {
+		return "amount must be positive"
+	}
+	if amount.BitLen() > 255 {
+		return "amount out of range"
+	}
+	return ""
+}
+
+// addPrecompileLog emits an event of a stateful precompiled contract, the addresses are
+// the indexed arguments of the event and data the others
+func addPrecompileLog(evm *EVM, contract *Contract, event abi.Event, indexed []sdk.AccAddress, data ...interface{}) {
+	topics := []sdk.Hash{sdk.BytesToHash(event.ID.Bytes())}
+	for _, addr := range indexed {
+		topics = append(topics, sdk.BytesToHash(addr.Bytes()))
+	}
+
+	bz, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		panic(err)
+	}
+
+	evm.StateDB.AddLog(&Log{
+		// the logs are filtered by the full address of the contract
+		Address:     sdk.AccAddress(ethcmn.BytesToAddress(contract.Address()).Bytes()),
+		Topics:      topics,
+		Data:        bz,
+		BlockNumber: evm.BlockNumber.Uint64(),
+	})
+}
@@ -0,0 +1,144 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	distr "github.com/Dipper-Labs/Dipper-Protocol/app/v0/distribution"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking"
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+const (
+	// stakingForwarderCode deploys a contract forwarding its calldata to the staking
+	// contract, it reverts if the staking contract does
+	stakingForwarderCode = "601e80600b6000396000f3" + "3660006000376000600036600060006101005af1601c5760006000fd5b00"
+	// stakingReverterCode deploys a contract forwarding its calldata to the staking
+	// contract and reverting afterwards
+	stakingReverterCode = "601a80600b6000396000f3" + "3660006000376000600036600060006101005af15060006000fd"
+)
+
+func stakingCall(t *testing.T, contractABI string, method string, args ...interface{}) []byte {
+	var payload []byte
+	var err error
+	if contractABI == DistributionContractABI {
+		payload, err = distributionABI.Pack(method, args...)
+	} else {
+		payload, err = stakingABI.Pack(method, args...)
+	}
+	require.NoError(t, err)
+	return payload
+}
+
+func TestStakingContract(t *testing.T) {
+	ctx, ak, vmKeeper, sk := keep.CreateTestInput(t, false, 1000000)
+	vmHandler := NewHandler(vmKeeper)
+	// every message runs as its own tx with a fresh gas meter
+	handler := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return vmHandler(ctx.WithGasMeter(sdk.NewGasMeter(10000000)), msg)
+	}
+	stakingKeeper := vmKeeper.StakingKeeper().(staking.Keeper)
+	distrKeeper := vmKeeper.DistributionKeeper().(distr.Keeper)
+	zero := sdk.NewInt64Coin(sdk.NativeTokenName, 0)
+	eth := func(addr []byte) ethcmn.Address { return ethcmn.BytesToAddress(addr) }
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		return ak.GetAccount(ctx, addr).GetCoins().AmountOf(sdk.NativeTokenName)
+	}
+	delegation := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) int64 {
+		d, found := stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return 0
+		}
+		return d.Shares.TruncateInt64()
+	}
+
+	valAddr := sdk.ValAddress(keep.Addrs[0])
+	_, err := staking.NewHandler(stakingKeeper)(ctx, staking.NewMsgCreateValidator(
+		valAddr, keep.PKs[0], sdk.NewInt64Coin(sdk.NativeTokenName, 1000), staking.Description{Moniker: "val"},
+		staking.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)), sdk.OneInt(),
+	))
+	require.NoError(t, err)
+
+	// an account delegates through the staking contract
+	delegator := keep.Addrs[1]
+	before := balance(delegator)
+	_, err = handler(ctx, types.NewMsgContract(delegator, StakingContractAddress, stakingCall(t, StakingContractABI, "delegate", eth(valAddr), big.NewInt(1000)), zero))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), delegation(delegator, valAddr))
+	require.Equal(t, before.SubRaw(1000), balance(delegator))
+
+	_, res, err := DoStateTransition(ctx, types.NewMsgContract(delegator, StakingContractAddress, stakingCall(t, StakingContractABI, "delegation", eth(delegator), eth(valAddr)), zero), vmKeeper, true)
+	require.NoError(t, err)
+	out, err := stakingABI.Methods["delegation"].Outputs.UnpackValues(res.Data)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000).Int, out[0].(*big.Int))
+	require.Equal(t, int64(1000), out[1].(*big.Int).Int64())
+
+	// the rewards of the delegation are withdrawn through the distribution contract
+	validator := stakingKeeper.Validator(ctx, valAddr)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 1000))
+	require.NoError(t, sk.SendCoinsFromAccountToModule(ctx, keep.Addrs[5], distr.ModuleName, rewards))
+	distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(rewards))
+
+	// rewards are only accrued from the block after the delegation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	before = balance(delegator)
+	res, err = handler(ctx, types.NewMsgContract(delegator, DistributionContractAddress, stakingCall(t, DistributionContractABI, "withdrawRewards", eth(valAddr)), zero))
+	require.NoError(t, err)
+	out, err = distributionABI.Methods["withdrawRewards"].Outputs.UnpackValues(res.Data)
+	require.NoError(t, err)
+	require.Equal(t, int64(450), out[0].(*big.Int).Int64())
+	require.Equal(t, before.AddRaw(450), balance(delegator))
+
+	// undelegating more than delegated reverts
+	_, err = handler(ctx, types.NewMsgContract(delegator, StakingContractAddress, stakingCall(t, StakingContractABI, "undelegate", eth(valAddr), big.NewInt(1001)), zero))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(1000), delegation(delegator, valAddr))
+
+	_, err = handler(ctx, types.NewMsgContract(delegator, StakingContractAddress, stakingCall(t, StakingContractABI, "undelegate", eth(valAddr), big.NewInt(400)), zero))
+	require.NoError(t, err)
+	require.Equal(t, int64(600), delegation(delegator, valAddr))
+	_, found := stakingKeeper.GetUnbondingDelegation(ctx, delegator, valAddr)
+	require.True(t, found)
+
+	// a contract delegates its own coins
+	deploy := func(deployer sdk.AccAddress, code string) sdk.AccAddress {
+		acc := ak.GetAccount(ctx, deployer)
+		contractAddr := CreateAddress(acc.GetAddress(), acc.GetSequence())
+		_, err := handler(ctx, types.NewMsgContract(deployer, nil, sdk.FromHex(code), sdk.NewInt64Coin(sdk.NativeTokenName, 5000)))
+		require.NoError(t, err)
+		return contractAddr
+	}
+	deployer := keep.Addrs[2]
+	forwarder, reverter := deploy(deployer, stakingForwarderCode), deploy(keep.Addrs[3], stakingReverterCode)
+
+	payload := stakingCall(t, StakingContractABI, "delegate", eth(valAddr), big.NewInt(2000))
+	_, err = handler(ctx, types.NewMsgContract(deployer, forwarder, payload, zero))
+	require.NoError(t, err)
+	require.Equal(t, int64(2000), delegation(forwarder, valAddr))
+	require.Equal(t, int64(3000), balance(forwarder).Int64())
+
+	// the delegation is dropped with the reverted frame
+	_, err = handler(ctx, types.NewMsgContract(deployer, reverter, payload, zero))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(0), delegation(reverter, valAddr))
+	require.Equal(t, int64(5000), balance(reverter).Int64())
+
+	// the failed delegation of the forwarded call makes the forwarder revert
+	_, err = handler(ctx, types.NewMsgContract(deployer, forwarder, stakingCall(t, StakingContractABI, "delegate", eth(valAddr), big.NewInt(3001)), zero))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(2000), delegation(forwarder, valAddr))
+
+	// the gas of the methods is a parameter
+	gasParams := vmKeeper.GetStakingPrecompileGasParams(ctx)
+	gasParams.Delegate = 100000000
+	vmKeeper.SetStakingPrecompileGasParams(ctx, gasParams)
+	_, err = handler(ctx, types.NewMsgContract(deployer, forwarder, stakingCall(t, StakingContractABI, "delegate", eth(valAddr), big.NewInt(1)), zero))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(2000), delegation(forwarder, valAddr))
+}
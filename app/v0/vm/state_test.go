@@ -0,0 +1,373 @@
+package vm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/params"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/store"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// stateTestsDir holds fixtures in the filled GeneralStateTests format of ethereum/tests,
+// more fixtures can be dropped in as they are. The post state root can not be compared,
+// the store is not a Merkle Patricia trie, the accounts are compared with the state of
+// the post entries instead.
+const stateTestsDir = "testdata/GeneralStateTests"
+
+// stateTestSkips are the known divergences of the vm from Ethereum, matched against
+// <code hash>/<fixture path>/<test name>
+var stateTestSkips = []struct {
+	pattern *regexp.Regexp
+	reason  string
+}{
+	{regexp.MustCompile(`^sha256/stExtCodeHash/`), "the code hash is sha256 before the eth compatible protocol version"},
+	{regexp.MustCompile(`^sha256/stCreateTest/`), "the contract addresses are derived from the sequence after the ante handler increased it before the eth compatible protocol version"},
+	{regexp.MustCompile(`/stCreateTest/createAfterCall\.json/`), "the nonce of a contract is increased by its calls"},
+	{regexp.MustCompile(`/stBlockInfo/difficulty\.json/`), "DIFFICULTY is always 1"},
+	{regexp.MustCompile(`/stBlockInfo/blockhash\.json/`), "BLOCKHASH is the hash of the last block whatever the number"},
+	{regexp.MustCompile(`/stChainId/chainId\.json/`), "CHAINID is the bytes of the chain id string"},
+}
+
+// stateTestForks are the forks of the post entries the vm runs
+var stateTestForks = map[string]types.Fork{
+	"Istanbul": types.ForkIstanbul,
+	"Berlin":   types.ForkBerlin,
+	"Cancun":   types.ForkCancun,
+}
+
+// txDataNonZeroGasEIP2028 is the intrinsic gas of a non zero byte of the tx data from Istanbul on
+const txDataNonZeroGasEIP2028 uint64 = 16
+
+type stateTest struct {
+	Env  stEnv                    `json:"env"`
+	Pre  core.GenesisAlloc        `json:"pre"`
+	Tx   stTransaction            `json:"transaction"`
+	Post map[string][]stPostState `json:"post"`
+}
+
+type stEnv struct {
+	Coinbase     ethcmn.Address        `json:"currentCoinbase"`
+	GasLimit     math.HexOrDecimal64   `json:"currentGasLimit"`
+	Number       math.HexOrDecimal64   `json:"currentNumber"`
+	Timestamp    math.HexOrDecimal64   `json:"currentTimestamp"`
+	BaseFee      *math.HexOrDecimal256 `json:"currentBaseFee"`
+	PreviousHash ethcmn.Hash           `json:"previousHash"`
+}
+
+type stTransaction struct {
+	GasPrice  *math.HexOrDecimal256 `json:"gasPrice"`
+	Nonce     math.HexOrDecimal64   `json:"nonce"`
+	To        string                `json:"to"`
+	Data      []string              `json:"data"`
+	GasLimit  []math.HexOrDecimal64 `json:"gasLimit"`
+	Value     []string              `json:"value"`
+	SecretKey hexutil.Bytes         `json:"secretKey"`
+}
+
+type stPostState struct {
+	Logs    ethcmn.Hash `json:"logs"`
+	Indexes struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+	State core.GenesisAlloc `json:"state"`
+}
+
+// TestGeneralStateTests runs the fixtures with the code hashes of the chain before and from
+// the eth compatible protocol version. The vm is given the gas schedule of Ethereum and the
+// test plays the part of the tx processing of Ethereum, the fees of the chain are taken by
+// the ante handler instead.
+func TestGeneralStateTests(t *testing.T) {
+	var files []string
+	err := filepath.Walk(stateTestsDir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && strings.HasSuffix(path, ".json") {
+			files = append(files, path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, mode := range []struct {
+		name          string
+		ethCompatible bool
+	}{{"sha256", false}, {"keccak", true}} {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			for _, file := range files {
+				runStateTestFile(t, file, mode.name, mode.ethCompatible)
+			}
+		})
+	}
+}
+
+func runStateTestFile(t *testing.T, file, mode string, ethCompatible bool) {
+	bz, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+
+	var tests map[string]stateTest
+	require.NoError(t, json.Unmarshal(bz, &tests), file)
+
+	rel, err := filepath.Rel(stateTestsDir, file)
+	require.NoError(t, err)
+	rel = filepath.ToSlash(rel)
+
+	names := make([]string, 0, len(tests))
+	for name := range tests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		test := tests[name]
+		t.Run(rel+"/"+name, func(t *testing.T) {
+			path := fmt.Sprintf("%s/%s/%s", mode, rel, name)
+			for _, skip := range stateTestSkips {
+				if skip.pattern.MatchString(path) {
+					t.Skip(skip.reason)
+				}
+			}
+
+			for forkName, posts := range test.Post {
+				fork, ok := stateTestForks[forkName]
+				if !ok {
+					continue
+				}
+				for i, post := range posts {
+					t.Run(fmt.Sprintf("%s/%d", forkName, i), func(t *testing.T) {
+						test.run(t, fork, post, ethCompatible)
+					})
+				}
+			}
+		})
+	}
+}
+
+// newStateTestDB returns a statedb over an in-memory multistore
+func newStateTestDB(ethCompatible bool) *types.CommitStateDB {
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	vmKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	paramsKeeper := params.NewKeeper(types.ModuleCdc, paramsKey, tParamsKey)
+	accountKeeper := auth.NewAccountKeeper(types.ModuleCdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeDB, db)
+	ms.MountStoreWithDB(vmKey, sdk.StoreTypeDB, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeDB, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	_ = ms.LoadLatestVersion()
+
+	stateDB := types.NewCommitStateDB(accountKeeper, vmKey).WithContext(sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger()))
+	stateDB.SetEthCompatible(ethCompatible)
+	return stateDB
+}
+
+func (test stateTest) run(t *testing.T, fork types.Fork, post stPostState, ethCompatible bool) {
+	stateDB := newStateTestDB(ethCompatible)
+	for addr, acc := range test.Pre {
+		address := sdk.AccAddress(addr.Bytes())
+		stateDB.SetBalance(address, acc.Balance)
+		stateDB.SetNonce(address, acc.Nonce)
+		stateDB.SetCode(address, acc.Code)
+		for key, value := range acc.Storage {
+			stateDB.SetState(address, sdk.Hash(key), sdk.Hash(value))
+		}
+	}
+	stateDB.Finalise(false)
+	_, err := stateDB.Commit(false)
+	require.NoError(t, err)
+
+	key, err := ethcrypto.ToECDSA(test.Tx.SecretKey)
+	require.NoError(t, err)
+	sender := sdk.AccAddress(ethcrypto.PubkeyToAddress(key.PublicKey).Bytes())
+
+	var recipient sdk.AccAddress
+	if test.Tx.To != "" {
+		recipient = sdk.AccAddress(ethcmn.HexToAddress(test.Tx.To).Bytes())
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(test.Tx.Data[post.Indexes.Data], "0x"))
+	require.NoError(t, err)
+	value := new(big.Int)
+	if v := test.Tx.Value[post.Indexes.Value]; v != "0x" {
+		var ok bool
+		value, ok = math.ParseBig256(v)
+		require.True(t, ok, v)
+	}
+	gasLimit := uint64(test.Tx.GasLimit[post.Indexes.Gas])
+	gasPrice := (*big.Int)(test.Tx.GasPrice)
+	coinbase := sdk.AccAddress(test.Env.Coinbase.Bytes())
+
+	// the txs Ethereum rejects leave the state as it is
+	intrinsicGas := stateTestIntrinsicGas(data, recipient.Empty())
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
+	if gasLimit >= intrinsicGas && stateDB.GetBalance(sender).Cmp(new(big.Int).Add(gasCost, value)) >= 0 {
+		stateDB.SubBalance(sender, gasCost)
+		// the ante handler increases the sequence of the sender before the vm runs, the vm
+		// increases it itself for an Ethereum creation, see StateTransition
+		if !ethCompatible || !recipient.Empty() {
+			stateDB.SetNonce(sender, stateDB.GetNonce(sender)+1)
+		}
+
+		leftOverGas := test.execute(stateDB, fork, sender, recipient, data, gasLimit-intrinsicGas, value, gasPrice)
+
+		gasUsed := gasLimit - leftOverGas
+		refund := stateDB.GetRefund()
+		if refund > gasUsed/2 {
+			refund = gasUsed / 2
+		}
+		leftOverGas += refund
+		stateDB.AddBalance(sender, new(big.Int).Mul(new(big.Int).SetUint64(leftOverGas), gasPrice))
+		stateDB.AddBalance(coinbase, new(big.Int).Mul(new(big.Int).SetUint64(gasLimit-leftOverGas), gasPrice))
+	}
+
+	logs := stateDB.GetLogs(stateDB.TxHash())
+	stateDB.Finalise(true)
+
+	require.Equal(t, post.Logs, stateTestLogsHash(logs), "logs hash")
+
+	for addr, acc := range post.State {
+		address := sdk.AccAddress(addr.Bytes())
+		require.Equal(t, acc.Balance.String(), stateDB.GetBalance(address).String(), "balance of %s", addr.Hex())
+		require.Equal(t, hexutil.Encode(acc.Code), hexutil.Encode(stateDB.GetCode(address)), "code of %s", addr.Hex())
+
+		// the slots of the pre state must have been cleared if they are not in the post state
+		slots := make(map[ethcmn.Hash]ethcmn.Hash)
+		for slot := range test.Pre[addr].Storage {
+			slots[slot] = ethcmn.Hash{}
+		}
+		for slot, value := range acc.Storage {
+			slots[slot] = value
+		}
+		for slot, value := range slots {
+			require.Equal(t, value.Hex(), ethcmn.Hash(stateDB.GetState(address, sdk.Hash(slot))).Hex(), "slot %s of %s", slot.Hex(), addr.Hex())
+		}
+	}
+
+	for addr := range test.Pre {
+		if _, ok := post.State[addr]; !ok {
+			require.True(t, stateDB.Empty(sdk.AccAddress(addr.Bytes())), "account %s must be deleted", addr.Hex())
+		}
+	}
+}
+
+// execute runs the tx in the vm and returns the gas left
+func (test stateTest) execute(stateDB *types.CommitStateDB, fork types.Fork, sender, recipient sdk.AccAddress,
+	data []byte, gas uint64, value, gasPrice *big.Int) uint64 {
+	st := StateTransition{StateDB: stateDB}
+
+	baseFee := new(big.Int)
+	if test.Env.BaseFee != nil {
+		baseFee = (*big.Int)(test.Env.BaseFee)
+	}
+	evmCtx := Context{
+		CanTransfer: st.CanTransfer,
+		Transfer:    st.Transfer,
+		GetHash: func() sdk.Hash {
+			return sdk.Hash(test.Env.PreviousHash)
+		},
+		Origin:      sender,
+		CoinBase:    sdk.AccAddress(test.Env.Coinbase.Bytes()),
+		GasPrice:    gasPrice,
+		GasLimit:    uint64(test.Env.GasLimit),
+		BlockNumber: new(big.Int).SetUint64(uint64(test.Env.Number)),
+		Time:        new(big.Int).SetUint64(uint64(test.Env.Timestamp)),
+		BaseFee:     baseFee,
+	}
+
+	vmParams := types.DefaultParams()
+	if fork >= types.ForkBerlin {
+		precompiles := types.ActivePrecompileAddresses(vmParams.Precompiles, int64(test.Env.Number))
+		stateDB.PrepareAccessList(sender, recipient, precompiles, nil)
+	}
+
+	opGasParams := vmParams.VMOpGasParams
+	if fork >= types.ForkBerlin {
+		opGasParams = BerlinVMOpGasParams(opGasParams)
+	}
+	if fork >= types.ForkCancun {
+		opGasParams = CancunVMOpGasParams(opGasParams)
+	}
+
+	cfg := Config{
+		OpConstGasConfig: &opGasParams,
+		// the creation gas of the chain is charged on top of the intrinsic gas and CREATE,
+		// Ethereum only charges the code deposit
+		ContractCreationGasConfig: &types.VMContractCreationGasParams{GasPerByte: CreateDataGas},
+		MaxCodeSize:               24576, // EIP-170
+		MaxCallCreateDepth:        CallCreateDepth,
+		Fork:                      fork,
+	}
+	evm := NewEVM(evmCtx, stateDB, cfg)
+
+	var leftOverGas uint64
+	if recipient.Empty() {
+		_, _, leftOverGas, _ = evm.Create(AccountRef(sender), data, gas, value)
+	} else {
+		_, leftOverGas, _ = evm.Call(AccountRef(sender), recipient, data, gas, value)
+	}
+	return leftOverGas
+}
+
+// stateTestIntrinsicGas returns the gas Ethereum charges a tx before running it
+func stateTestIntrinsicGas(data []byte, contractCreation bool) uint64 {
+	gas := TxGas
+	if contractCreation {
+		gas = TxGasContractCreation
+	}
+	for _, b := range data {
+		if b == 0 {
+			gas += TxDataZeroGas
+		} else {
+			gas += txDataNonZeroGasEIP2028
+		}
+	}
+	return gas
+}
+
+// stateTestLogsHash returns the keccak256 hash of the rlp encoded logs as the fixtures do
+func stateTestLogsHash(logs []*types.Log) ethcmn.Hash {
+	ethLogs := make([]*ethtypes.Log, len(logs))
+	for i, l := range logs {
+		topics := make([]ethcmn.Hash, len(l.Topics))
+		for j, topic := range l.Topics {
+			topics[j] = ethcmn.Hash(topic)
+		}
+		ethLogs[i] = &ethtypes.Log{Address: ethcmn.BytesToAddress(l.Address), Topics: topics, Data: l.Data}
+	}
+
+	bz, err := rlp.EncodeToBytes(ethLogs)
+	if err != nil {
+		panic(err)
+	}
+	return ethcrypto.Keccak256Hash(bz)
+}
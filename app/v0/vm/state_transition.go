@@ -5,8 +5,8 @@ import (
 	"math/big"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/tmhash"
 
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
@@ -21,7 +21,14 @@ type StateTransition struct {
 	Recipient sdk.AccAddress
 	Amount    sdk.Int
 	Payload   []byte
-	StateDB   *types.CommitStateDB
+	ABI       string
+	Metadata  string
+	Admin     sdk.AccAddress
+	GasPrice  *big.Int
+	// AccessList is charged up front and warm from the start of the execution
+	AccessList types.AccessList
+	StateDB    *types.CommitStateDB
+	Tracer     Tracer
 }
 
 func (st StateTransition) CanTransfer(acc sdk.AccAddress, amount *big.Int) bool {
@@ -51,10 +58,17 @@ func (st StateTransition) TransitionCSDB(ctx sdk.Context, k Keeper) (*big.Int, *
 		GetHash:     st.GetHashFn(ctx.BlockHeader()),
 		Origin:      st.Sender,
 		CoinBase:    ctx.BlockHeader().ProposerAddress,
+		GasPrice:    st.GasPrice,
 		Time:        sdk.NewInt(ctx.BlockHeader().Time.Unix()).BigInt(),
 		BlockNumber: sdk.NewInt(ctx.BlockHeader().Height).BigInt(),
 	}
 
+	// the access list is the intrinsic gas of EIP 2930, it is charged before the vm gets its limit
+	if len(st.AccessList) > 0 {
+		accessListGas := uint64(len(st.AccessList))*TxAccessListAddressGas + uint64(st.AccessList.StorageKeys())*TxAccessListStorageKeyGas
+		ctx.GasMeter().ConsumeGas(accessListGas, "access list")
+	}
+
 	gasLimitForVM := DefaultBlockGasLimit
 	if ctx.BlockGasMeter() != nil {
 		gasLimitForVM = ctx.BlockGasMeter().Limit()
@@ -74,33 +88,77 @@ func (st StateTransition) TransitionCSDB(ctx sdk.Context, k Keeper) (*big.Int, *
 	vmParams := k.GetParams(ctx) // will consume gas
 	st.StateDB.UpdateAccounts()  // will consume gas
 
+	// the sender, the recipient, the precompiled contracts and the access list are warm
+	// from the start, the created contract is warmed by the creation
+	if k.Fork() >= types.ForkBerlin {
+		precompiles := types.ActivePrecompileAddresses(vmParams.Precompiles, ctx.BlockHeight())
+		st.StateDB.PrepareAccessList(st.Sender, st.Recipient, precompiles, st.AccessList)
+	}
+
+	// the msgs run outside of a tx, e.g. by queries, see the min gas price
+	if evmCtx.GasPrice == nil {
+		evmCtx.GasPrice = new(big.Int).SetUint64(vmParams.MinGasPrice)
+	}
+	evmCtx.BaseFee = new(big.Int).SetUint64(vmParams.MinGasPrice)
+
+	// the contracts are frozen by the rent they owe from the storage rent version on
+	var rentRate uint64
+	if st.StateDB.StorageRent() {
+		rentRate = vmParams.StorageRentRate
+	}
+
 	cfg := Config{
+		Debug:                     st.Tracer != nil,
+		Tracer:                    st.Tracer,
 		OpConstGasConfig:          &vmParams.VMOpGasParams,
 		ContractCreationGasConfig: &vmParams.VMContractCreationGasParams,
 		MaxCodeSize:               vmParams.MaxCodeSize,
 		MaxCallCreateDepth:        vmParams.MaxCallCreateDepth,
+		Precompiles:               newPrecompileResolver(k, st.StateDB),
+		CanDeploy: func(origin sdk.AccAddress) bool {
+			return k.CanDeploy(ctx, origin)
+		},
+		// read through the statedb of the evm, the keeper would switch its context
+		IsFrozen: func(addr sdk.AccAddress) bool {
+			return st.StateDB.GetContractRent(addr).IsFrozenAt(rentRate, ctx.BlockHeight())
+		},
+		Fork: k.Fork(),
+	}
+
+	// the contracts disabled by the guardians are not run by the msgs of a tx, be they called
+	// by the msg or by another contract. The msgs of a passed proposal run them as they do
+	// the disabled routes
+	_, isProposalMsg := govtypes.GetProposalMsg(ctx)
+	if !isProposalMsg {
+		cfg.IsHalted = func(addr sdk.AccAddress) bool {
+			return k.IsContractHalted(ctx, addr)
+		}
 	}
 	evm := NewEVM(evmCtx, st.StateDB.WithContext(ctx.WithGasMeter(gasMeterForEvm)), cfg)
 
 	var (
-		ret         []byte
-		leftOverGas uint64
-		vmerr       error
+		ret          []byte
+		contractAddr sdk.AccAddress
+		leftOverGas  uint64
+		vmerr        error
+		createNonce  uint64
 	)
 
 	if st.Recipient.Empty() {
-		ret, _, leftOverGas, vmerr = evm.Create(st.Sender, st.Payload, gasLimitForVM, st.Amount.BigInt())
+		// the ante handler increased the sequence the tx was signed with already, the first
+		// creation of the tx derives the contract address from it and increases it in its
+		// place, as Ethereum does. The governance account has no sequence of its own, the
+		// msgs of a proposal always create from the governance sequence they are signed with
+		if evm.StateDB.EthCompatible() || isProposalMsg {
+			if nonce, ok := types.TakeSignedNonce(ctx, st.Sender); ok {
+				evm.StateDB.SetNonce(st.Sender, nonce)
+			}
+		}
+		createNonce = evm.StateDB.GetNonce(st.Sender)
+		ret, contractAddr, leftOverGas, vmerr = evm.Create(st.Sender, st.Payload, gasLimitForVM, st.Amount.BigInt())
 		logger.Info(fmt.Sprintf("create contract, consumed gas = %v, leftOverGas = %v, vm err = %v ", gasLimitForVM-leftOverGas, leftOverGas, vmerr))
 	} else {
 		ret, leftOverGas, vmerr = evm.Call(st.Sender, st.Recipient, st.Payload, gasLimitForVM, st.Amount.BigInt())
-		if vmerr == ErrExecutionReverted {
-			reason := "null"
-			if len(ret) > 4 {
-				reason = string(ret[4:])
-			}
-			logger.Info(fmt.Sprintf("VM revert error, reason provided by the contract: %s", reason))
-		}
-
 		logger.Info(fmt.Sprintf("call contract, ret = %x, consumed gas = %v, leftOverGas = %v, vm err = %v", ret, gasLimitForVM-leftOverGas, leftOverGas, vmerr))
 	}
 
@@ -109,6 +167,19 @@ func (st StateTransition) TransitionCSDB(ctx sdk.Context, k Keeper) (*big.Int, *
 	if vmerr != nil {
 		ctx.EventManager().Clear()
 		ctx.WithGasMeter(curGasMeter).GasMeter().ConsumeGas(vmGasUsed, "VM execution consumption")
+		// the writes of a failed tx are discarded, the receipt is persisted on commit
+		if !ctx.Simulate && !ctx.IsCheckTx() {
+			st.StateDB.AddFailedReceipt(st.newReceipt(ctx, types.ReceiptStatusFailed, nil, nil))
+		}
+
+		failedContract := st.Recipient
+		if failedContract.Empty() {
+			failedContract = evm.createAddress(st.Sender, createNonce)
+		}
+		st.emitFailedEvent(ctx, failedContract, vmerr, ret)
+		vmerr = types.ErrorFromVMError(vmerr, failedContract, ret)
+		logger.Info(fmt.Sprintf("vm execution failed: %v", vmerr))
+
 		return nil, &sdk.Result{Data: ret, GasUsed: curGasMeter.GasConsumed() + vmGasUsed}, vmerr
 	}
 
@@ -117,16 +188,93 @@ func (st StateTransition) TransitionCSDB(ctx sdk.Context, k Keeper) (*big.Int, *
 	// comsume vm gas
 	ctx.WithGasMeter(curGasMeter).GasMeter().ConsumeGas(vmGasUsed, "VM execution consumption")
 
+	if !ctx.Simulate {
+		logs := st.StateDB.GetLogs(st.StateDB.TxHash())
+		st.StateDB.SetReceipt(st.newReceipt(ctx, types.ReceiptStatusSuccessful, contractAddr, logs))
+
+		// the deployer is recorded even without an abi, it may submit one later
+		if st.Recipient.Empty() {
+			meta := types.NewContractMeta(st.Sender, st.ABI, st.Metadata)
+			meta.Admin = st.Admin
+			stateDB := st.StateDB.WithContext(ctx)
+			stateDB.SetContractMeta(contractAddr, meta)
+			stateDB.AppendCodeHistory(contractAddr, types.CodeHistoryOperationCreate, stateDB.GetCodeHash(contractAddr))
+		}
+
+		// the contracts created by contracts record the tx sender as their deployer, the
+		// reverted creations left no code
+		stateDB := st.StateDB.WithContext(ctx)
+		for _, addr := range evm.created {
+			codeHash := stateDB.GetCodeHash(addr)
+			if codeHash == (sdk.Hash{}) || stateDB.GetContractMeta(addr) != nil {
+				continue
+			}
+			stateDB.SetContractMeta(addr, types.NewContractMeta(st.Sender, "", ""))
+			stateDB.AppendCodeHistory(addr, types.CodeHistoryOperationCreate, codeHash)
+		}
+	}
+
 	return nil, &sdk.Result{Data: ret, GasUsed: ctx.GasMeter().GasConsumed()}, nil
 }
 
+// emitFailedEvent emits the failing contract, the vm error class and the revert reason
+// decoded from the returned data if there is one
+func (st StateTransition) emitFailedEvent(ctx sdk.Context, contract sdk.AccAddress, vmerr error, ret []byte) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAddress, contract.String()),
+		sdk.NewAttribute(types.AttributeKeyVMError, types.VMErrorClass(vmerr)),
+	}
+	if types.ErrExecutionReverted.Is(vmerr) {
+		if reason, ok := types.UnpackRevertReason(ret); ok {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyReason, reason))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeContractFailed, attrs...))
+}
+
+// newReceipt builds the receipt of the tx, the gas used is the gas consumed by
+// the whole tx so far and the cumulative gas includes the previous txs of the block
+func (st StateTransition) newReceipt(ctx sdk.Context, status uint64, contractAddr sdk.AccAddress, logs []*types.Log) *types.Receipt {
+	gasUsed := ctx.GasMeter().GasConsumed()
+	cumulativeGasUsed := gasUsed
+	if ctx.BlockGasMeter() != nil {
+		cumulativeGasUsed += ctx.BlockGasMeter().GasConsumed()
+	}
+
+	if logs == nil {
+		logs = []*types.Log{}
+	}
+
+	return &types.Receipt{
+		TxHash:            st.StateDB.TxHash(),
+		BlockNumber:       uint64(ctx.BlockHeight()),
+		Status:            status,
+		From:              st.Sender,
+		To:                st.Recipient,
+		ContractAddress:   contractAddr,
+		GasUsed:           gasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+		Bloom:             types.CreateBloom(logs),
+	}
+}
+
 func DoStateTransition(ctx sdk.Context, msg types.MsgContract, k Keeper, readonly bool) (*big.Int, *sdk.Result, error) {
 	st := StateTransition{
-		Sender:    msg.From,
-		Recipient: msg.To,
-		Payload:   msg.Payload,
-		Amount:    msg.Amount.Amount,
-		StateDB:   k.StateDB.WithContext(ctx).WithTxHash(tmhash.Sum(ctx.TxBytes())),
+		Sender:     msg.From,
+		Recipient:  msg.To,
+		Payload:    msg.Payload,
+		Amount:     msg.Amount.Amount,
+		ABI:        msg.ABI,
+		Metadata:   msg.Metadata,
+		Admin:      msg.Admin,
+		AccessList: msg.AccessList,
+		StateDB:    k.StateDB.WithContext(ctx).WithTxHash(types.GetTxHash(ctx)),
+	}
+
+	if gasPrice, ok := types.GetGasPrice(ctx); ok {
+		st.GasPrice = gasPrice.BigInt()
 	}
 
 	if readonly {
@@ -137,9 +285,64 @@ func DoStateTransition(ctx sdk.Context, msg types.MsgContract, k Keeper, readonl
 		return nil, &sdk.Result{Data: nil}, ErrWrongCtx
 	}
 
+	if len(msg.AccessList) > 0 && k.Fork() < types.ForkBerlin {
+		return nil, &sdk.Result{Data: nil}, types.ErrAccessListNotSupported
+	}
+
 	if ctx.Simulate {
 		st.StateDB = types.NewStateDB(k.StateDB).WithContext(ctx)
 	}
 
-	return st.TransitionCSDB(ctx, k)
+	// the msgs of a proposal run outside of a tx on a context that is dropped when one of
+	// them fails, their writes are kept in its store rather than in the shared state objects
+	proposalMsg, isProposalMsg := govtypes.GetProposalMsg(ctx)
+	if !isProposalMsg || ctx.Simulate {
+		return st.TransitionCSDB(ctx, k)
+	}
+
+	ctx = types.WithSignedNonces(ctx, types.SignedNonces{msg.From.String(): proposalMsg.Sequence})
+	st.StateDB = types.NewStateDB(k.StateDB).WithContext(ctx).WithTxHash(types.GetTxHash(ctx))
+	gasPrice, result, err := st.TransitionCSDB(ctx, k)
+	if err != nil {
+		return gasPrice, result, err
+	}
+
+	if _, err := st.StateDB.Commit(true); err != nil {
+		return gasPrice, result, err
+	}
+
+	return gasPrice, result, nil
+}
+
+// TraceStateTransition runs msg in simulate mode on a fresh gas meter with the tracer
+// capturing the execution. The writes of a successful msg are kept in the store of ctx
+// so that the msgs of a block can be replayed one after the other.
+func TraceStateTransition(ctx sdk.Context, msg types.MsgContract, txHash sdk.Hash, k Keeper, tracer Tracer) (*sdk.Result, error) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	ctx.Simulate = true
+
+	st := StateTransition{
+		Sender:     msg.From,
+		Recipient:  msg.To,
+		Payload:    msg.Payload,
+		Amount:     msg.Amount.Amount,
+		AccessList: msg.AccessList,
+		StateDB:    types.NewStateDB(k.StateDB).WithContext(ctx).WithTxHash(txHash.Bytes()),
+		Tracer:     tracer,
+	}
+
+	if gasPrice, ok := types.GetGasPrice(ctx); ok {
+		st.GasPrice = gasPrice.BigInt()
+	}
+
+	_, result, err := st.TransitionCSDB(ctx, k)
+	if err != nil {
+		return result, err
+	}
+
+	if _, err := st.StateDB.Commit(true); err != nil {
+		return result, err
+	}
+
+	return result, nil
 }
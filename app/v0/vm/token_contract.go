@@ -0,0 +1,204 @@
+package vm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	supplyexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common/math"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// TokenContractABI is the ERC-20 interface implemented by the contracts of the issued tokens
+const TokenContractABI = `[
+{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+var (
+	tokenABI abi.ABI
+
+	// tokenMethodGas is the gas price of the methods of the token contracts
+	tokenMethodGas = map[string]uint64{
+		"name":         TokenQueryGas,
+		"symbol":       TokenQueryGas,
+		"decimals":     TokenQueryGas,
+		"totalSupply":  TokenQueryGas,
+		"balanceOf":    TokenQueryGas,
+		"allowance":    TokenQueryGas,
+		"transfer":     TokenTransferGas,
+		"approve":      TokenApproveGas,
+		"transferFrom": TokenTransferGas,
+	}
+
+	// maxAllowance is the allowance which transferFrom does not decrease
+	maxAllowance = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+func init() {
+	var err error
+	tokenABI, err = abi.JSON(strings.NewReader(TokenContractABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// tokenContract is the ERC-20 contract of a denom issued through the supply module. The
+// balances are the bank balances of the accounts and the transfers go through the bank
+// keeper, the allowances are kept in the storage of the contract.
+type tokenContract struct {
+	meta supplyexported.TokenMetaI
+	bk   types.BankKeeper
+	sk   types.SupplyKeeper
+}
+
+// resolveTokenContract returns the contract of the token issued through the supply module
+// at addr, nil if there is none
+func resolveTokenContract(k Keeper, stateDB *types.CommitStateDB, addr sdk.AccAddress) StatefulPrecompiledContract {
+	if k.SupplyKeeper() == nil {
+		return nil
+	}
+
+	meta, found := k.SupplyKeeper().GetTokenMetaByContract(stateDB.Context(), addr)
+	if !found {
+		return nil
+	}
+	return &tokenContract{meta: meta, bk: k.BankKeeper(), sk: k.SupplyKeeper()}
+}
+
+func (c *tokenContract) RequiredGas(input []byte) uint64 {
+	if len(input) >= 4 {
+		if method, err := tokenABI.MethodById(input[:4]); err == nil {
+			return tokenMethodGas[method.Name]
+		}
+	}
+	return TokenQueryGas
+}
+
+func (c *tokenContract) Run(evm *EVM, contract *Contract, input []byte, readOnly bool) ([]byte, error) {
+	method, args, err := unpackPrecompileInput(tokenABI, contract, input)
+	if err != nil {
+		return revertWith(err.Error())
+	}
+
+	denom := c.meta.GetDenom()
+	caller := contract.Caller()
+
+	switch method.Name {
+	case "name", "symbol":
+		return method.Outputs.Pack(denom)
+	case "decimals":
+		return method.Outputs.Pack(c.meta.GetDecimals())
+	case "totalSupply":
+		supply := c.sk.GetSupply(evm.StateDB.Context()).GetTotal().AmountOf(denom)
+		return method.Outputs.Pack(supply.BigInt())
+	case "balanceOf":
+		return method.Outputs.Pack(evm.StateDB.GetCoinBalance(addressArg(args[0]), denom))
+	case "allowance":
+		return method.Outputs.Pack(c.allowance(evm, contract, addressArg(args[0]), addressArg(args[1])))
+	}
+
+	if readOnly {
+		return nil, ErrWriteProtection
+	}
+
+	switch method.Name {
+	case "transfer":
+		if reason := c.transfer(evm, contract, caller, addressArg(args[0]), args[1].(*big.Int)); reason != "" {
+			return revertWith(reason)
+		}
+	case "approve":
+		c.approve(evm, contract, caller, addressArg(args[0]), args[1].(*big.Int))
+	case "transferFrom":
+		from, to, value := addressArg(args[0]), addressArg(args[1]), args[2].(*big.Int)
+		allowance := c.allowance(evm, contract, from, caller)
+		if allowance.Cmp(value) < 0 {
+			return revertWith("insufficient allowance")
+		}
+		if allowance.Cmp(maxAllowance) != 0 {
+			c.setAllowance(evm, contract, from, caller, new(big.Int).Sub(allowance, value))
+		}
+		if reason := c.transfer(evm, contract, from, to, value); reason != "" {
+			return revertWith(reason)
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// transfer sends value from one account to another through the bank keeper, it returns
+// the revert reason if the transfer fails
+func (c *tokenContract) transfer(evm *EVM, contract *Contract, from, to sdk.AccAddress, value *big.Int) string {
+	if value.BitLen() > 255 {
+		return "value out of range"
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(c.meta.GetDenom(), sdk.NewIntFromBigInt(value)))
+	err := evm.StateDB.NativeCall(func(ctx sdk.Context) error {
+		if !c.bk.GetSendEnabled(ctx) {
+			return fmt.Errorf("transfers are disabled")
+		}
+		if c.bk.BlacklistedAddr(to) {
+			return fmt.Errorf("%s is not allowed to receive transfers", to)
+		}
+		return c.bk.SendCoins(ctx, from, to, coins)
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	c.addLog(evm, contract, "Transfer", from, to, value)
+	return ""
+}
+
+// approve sets the amount spender may transfer from the account of owner
+func (c *tokenContract) approve(evm *EVM, contract *Contract, owner, spender sdk.AccAddress, value *big.Int) {
+	c.setAllowance(evm, contract, owner, spender, value)
+	c.addLog(evm, contract, "Approval", owner, spender, value)
+}
+
+func (c *tokenContract) allowance(evm *EVM, contract *Contract, owner, spender sdk.AccAddress) *big.Int {
+	return evm.StateDB.GetState(contract.Address(), allowanceKey(owner, spender)).Big()
+}
+
+func (c *tokenContract) setAllowance(evm *EVM, contract *Contract, owner, spender sdk.AccAddress, value *big.Int) {
+	evm.StateDB.SetState(contract.Address(), allowanceKey(owner, spender), sdk.BigToHash(value))
+}
+
+// addLog emits a Transfer or Approval event of the contract
+func (c *tokenContract) addLog(evm *EVM, contract *Contract, event string, from, to sdk.AccAddress, value *big.Int) {
+	evm.StateDB.AddLog(&Log{
+		Address: contract.Address(),
+		Topics: []sdk.Hash{
+			sdk.BytesToHash(tokenABI.Events[event].ID.Bytes()),
+			sdk.BytesToHash(from.Bytes()),
+			sdk.BytesToHash(to.Bytes()),
+		},
+		Data:        math.PaddedBigBytes(value, 32),
+		BlockNumber: evm.BlockNumber.Uint64(),
+	})
+}
+
+// allowanceKey returns the storage key of the allowance of spender over the account of owner
+func allowanceKey(owner, spender sdk.AccAddress) sdk.Hash {
+	return sdk.BytesToHash(ethcrypto.Keccak256(owner.Bytes(), spender.Bytes()))
+}
+
+func addressArg(arg interface{}) sdk.AccAddress {
+	return sdk.AccAddress(arg.(ethcmn.Address).Bytes())
+}
@@ -0,0 +1,89 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/token"
+	keep "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/keeper"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func tokenCall(t *testing.T, method string, args ...interface{}) []byte {
+	payload, err := tokenABI.Pack(method, args...)
+	require.NoError(t, err)
+	return payload
+}
+
+func tokenQuery(t *testing.T, ctx sdk.Context, k Keeper, contract sdk.AccAddress, method string, args ...interface{}) *big.Int {
+	msg := types.NewMsgContract(keep.Addrs[0], contract, tokenCall(t, method, args...), sdk.NewInt64Coin(sdk.NativeTokenName, 0))
+	_, res, err := DoStateTransition(ctx, msg, k, true)
+	require.NoError(t, err)
+
+	out, err := tokenABI.Methods[method].Outputs.UnpackValues(res.Data)
+	require.NoError(t, err)
+	return out[0].(*big.Int)
+}
+
+func TestTokenContract(t *testing.T) {
+	ctx, ak, vmKeeper, sk := keep.CreateTestInput(t, false, 1000000)
+	handler := NewHandler(vmKeeper)
+	owner, alice, bob := keep.Addrs[0], keep.Addrs[1], keep.Addrs[2]
+	eth := func(addr sdk.AccAddress) ethcmn.Address { return ethcmn.BytesToAddress(addr) }
+	balance := func(addr sdk.AccAddress) int64 {
+		return ak.GetAccount(ctx, addr).GetCoins().AmountOf("usdx").Int64()
+	}
+
+	tk := token.NewKeeper(ak, sk)
+	require.NoError(t, tk.IssueToken(ctx, supply.NewTokenMeta("usdx", owner, 6, sdk.ZeroInt()), sdk.NewInt(1000)))
+	contract := supply.TokenContractAddress("usdx")
+
+	require.Equal(t, int64(1000), tokenQuery(t, ctx, vmKeeper, contract, "totalSupply").Int64())
+	require.Equal(t, int64(1000), tokenQuery(t, ctx, vmKeeper, contract, "balanceOf", eth(owner)).Int64())
+
+	// transfer moves bank coins and emits the Transfer event
+	ctx = ctx.WithTxBytes([]byte("transfer"))
+	_, err := handler(ctx, types.NewMsgContract(owner, contract, tokenCall(t, "transfer", eth(alice), big.NewInt(100)), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	require.Equal(t, int64(900), balance(owner))
+	require.Equal(t, int64(100), balance(alice))
+
+	logs := vmKeeper.GetLogs(ctx, sdk.BytesToHash(tmhash.Sum(ctx.TxBytes())))
+	require.Len(t, logs, 1)
+	require.Equal(t, contract, logs[0].Address)
+	require.Equal(t, sdk.BytesToHash(tokenABI.Events["Transfer"].ID.Bytes()), logs[0].Topics[0])
+	EndBlocker(ctx, vmKeeper)
+
+	// transfers over the balance revert without moving coins
+	_, err = handler(ctx, types.NewMsgContract(alice, contract, tokenCall(t, "transfer", eth(bob), big.NewInt(101)), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(100), balance(alice))
+	require.Equal(t, int64(0), balance(bob))
+
+	// bob spends the allowance alice approved
+	_, err = handler(ctx, types.NewMsgContract(alice, contract, tokenCall(t, "approve", eth(bob), big.NewInt(50)), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	require.Equal(t, int64(50), tokenQuery(t, ctx, vmKeeper, contract, "allowance", eth(alice), eth(bob)).Int64())
+
+	_, err = handler(ctx, types.NewMsgContract(bob, contract, tokenCall(t, "transferFrom", eth(alice), eth(owner), big.NewInt(30)), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.NoError(t, err)
+	require.Equal(t, int64(70), balance(alice))
+	require.Equal(t, int64(930), balance(owner))
+	require.Equal(t, int64(20), tokenQuery(t, ctx, vmKeeper, contract, "allowance", eth(alice), eth(bob)).Int64())
+
+	_, err = handler(ctx, types.NewMsgContract(bob, contract, tokenCall(t, "transferFrom", eth(alice), eth(owner), big.NewInt(30)), sdk.NewInt64Coin(sdk.NativeTokenName, 0)))
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.Equal(t, int64(70), balance(alice))
+	require.Equal(t, int64(20), tokenQuery(t, ctx, vmKeeper, contract, "allowance", eth(alice), eth(bob)).Int64())
+	EndBlocker(ctx, vmKeeper)
+
+	// the allowances are kept across blocks
+	require.Equal(t, int64(20), tokenQuery(t, ctx, vmKeeper, contract, "allowance", eth(alice), eth(bob)).Int64())
+}
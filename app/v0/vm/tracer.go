@@ -0,0 +1,231 @@
+package vm
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+var _ Tracer = (*CallTracer)(nil)
+
+// callFrame is a call of the call tree which is still running
+type callFrame struct {
+	types.CallFrame
+
+	// gasBase minus the gas left in the caller once the call returned is the gas used by the call
+	gasBase uint64
+	outOff  uint64
+	outLen  uint64
+}
+
+// CallTracer is a Tracer which builds the tree of the calls and creates made by a tx
+// instead of logging every step, it follows the callTracer of go-ethereum.
+type CallTracer struct {
+	// frames holds the running calls, the root call is frames[0] and the code
+	// executed in frames[i] runs at depth i+1
+	frames []*callFrame
+	root   types.CallFrame
+}
+
+// NewCallTracer returns a new call tracer
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart implements the Tracer interface to initialize the root call
+func (t *CallTracer) CaptureStart(from sdk.AccAddress, to sdk.AccAddress, create bool, input []byte, gas uint64, value *big.Int) error {
+	typ := CALL.String()
+	if create {
+		typ = CREATE.String()
+	}
+
+	t.frames = []*callFrame{{
+		CallFrame: types.CallFrame{
+			Type:  typ,
+			From:  from,
+			To:    to,
+			Value: bigString(value),
+			Gas:   gas,
+			Input: copyBytes(input),
+		},
+	}}
+	return nil
+}
+
+// CaptureState closes the calls which have returned and opens a new frame on
+// every call and create op
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth uint64, err error) error {
+	if len(t.frames) == 0 {
+		return nil
+	}
+
+	// the calls deeper than the current depth have returned, the result of the
+	// innermost one is on top of the stack of its caller
+	for uint64(len(t.frames)) > depth && len(t.frames) > 1 {
+		t.pop(uint64(len(t.frames)) == depth+1, gas, memory, stack)
+	}
+
+	if err != nil {
+		return t.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+
+	switch op {
+	case CREATE, CREATE2:
+		forwarded := gas - cost
+		forwarded -= forwarded / 64
+		t.frames = append(t.frames, &callFrame{
+			CallFrame: types.CallFrame{
+				Type:  op.String(),
+				From:  contract.Address(),
+				Value: bigString(stack.Back(0)),
+				Gas:   forwarded,
+				Input: memoryCopy(memory, stack.Back(1), stack.Back(2)),
+			},
+			gasBase: gas - cost,
+		})
+
+	case CALL, CALLCODE, DELEGATECALL, STATICCALL:
+		// the value is only taken from the stack by CALL and CALLCODE
+		argOff := 2
+		value := "0"
+		forwarded := env.callGasTemp
+		if op == CALL || op == CALLCODE {
+			argOff = 3
+			value = bigString(stack.Back(2))
+			if stack.Back(2).Sign() != 0 {
+				forwarded += CallStipend
+			}
+		}
+		if op == DELEGATECALL {
+			value = bigString(contract.Value())
+		}
+
+		t.frames = append(t.frames, &callFrame{
+			CallFrame: types.CallFrame{
+				Type:  op.String(),
+				From:  contract.Address(),
+				To:    sdk.BigToAddress(stack.Back(1)),
+				Value: value,
+				Gas:   forwarded,
+				Input: memoryCopy(memory, stack.Back(argOff), stack.Back(argOff+1)),
+			},
+			gasBase: gas - cost + forwarded,
+			outOff:  stack.Back(argOff + 2).Uint64(),
+			outLen:  stack.Back(argOff + 3).Uint64(),
+		})
+	}
+
+	return nil
+}
+
+// CaptureFault records the error on the call running at depth
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth uint64, err error) error {
+	if depth == 0 || uint64(len(t.frames)) < depth {
+		return nil
+	}
+
+	frame := t.frames[depth-1]
+	if frame.Error == "" {
+		frame.Error = err.Error()
+	}
+	return nil
+}
+
+// CaptureEnd sets the result of the root call
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	if len(t.frames) == 0 {
+		return nil
+	}
+
+	// close the calls which have not seen their caller resume
+	for len(t.frames) > 1 {
+		t.pop(false, 0, nil, nil)
+	}
+
+	root := t.frames[0]
+	root.Output = copyBytes(output)
+	root.GasUsed = gasUsed
+	if err != nil {
+		root.Error = err.Error()
+	} else {
+		root.Error = ""
+	}
+
+	t.root = root.CallFrame
+	t.frames = nil
+	return nil
+}
+
+// CallFrame returns the call tree of the traced tx
+func (t *CallTracer) CallFrame() types.CallFrame {
+	return t.root
+}
+
+// pop closes the innermost running call. When resumed is set the caller has
+// resumed execution and its gas, memory and stack hold the result of the call.
+func (t *CallTracer) pop(resumed bool, gas uint64, memory *Memory, stack *Stack) {
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	if !resumed {
+		if frame.Error == "" {
+			frame.Error = "internal failure"
+		}
+	} else {
+		ret := stack.Back(0)
+		if ret.Sign() == 0 {
+			if frame.Error == "" {
+				frame.Error = "internal failure"
+			}
+		} else {
+			frame.Error = ""
+			if frame.Type == CREATE.String() || frame.Type == CREATE2.String() {
+				frame.To = sdk.BigToAddress(ret)
+			}
+		}
+
+		if frame.gasBase > gas {
+			frame.GasUsed = frame.gasBase - gas
+		}
+		if frame.Type != CREATE.String() && frame.Type != CREATE2.String() {
+			frame.Output = memoryCopy(memory, new(big.Int).SetUint64(frame.outOff), new(big.Int).SetUint64(frame.outLen))
+		}
+	}
+
+	parent := t.frames[len(t.frames)-1]
+	parent.Calls = append(parent.Calls, frame.CallFrame)
+}
+
+// memoryCopy returns a copy of size bytes of the memory at offset, or nil if
+// the range is outside of the memory
+func memoryCopy(memory *Memory, offset, size *big.Int) []byte {
+	if !offset.IsUint64() || !size.IsUint64() {
+		return nil
+	}
+
+	off, n := offset.Uint64(), size.Uint64()
+	if n == 0 || off+n < off || off+n > uint64(memory.Len()) {
+		return nil
+	}
+
+	return copyBytes(memory.Data()[off : off+n])
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+
+	cpy := make([]byte, len(bz))
+	copy(cpy, bz)
+	return cpy
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return "0"
+	}
+	return i.String()
+}
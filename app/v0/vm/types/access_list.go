@@ -0,0 +1,177 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// AccessTuple is an account and the storage slots of it a tx declares to access, see EIP-2930
+type AccessTuple struct {
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	StorageKeys []sdk.Hash     `json:"storage_keys" yaml:"storage_keys"`
+}
+
+// AccessList is the list of the accounts and storage slots a tx declares to access, they
+// are warm from the start of the tx
+type AccessList []AccessTuple
+
+// StorageKeys returns the number of storage slots of the access list
+func (al AccessList) StorageKeys() int {
+	n := 0
+	for _, tuple := range al {
+		n += len(tuple.StorageKeys)
+	}
+	return n
+}
+
+// ValidateBasic checks the addresses of the access list
+func (al AccessList) ValidateBasic() error {
+	for _, tuple := range al {
+		if len(tuple.Address) != sdk.AddrLen {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "access list address %x", tuple.Address.Bytes())
+		}
+	}
+	return nil
+}
+
+// accessList is the set of the accounts and storage slots accessed by the current tx, see
+// EIP-2929. Only the accounts holding warm slots have a slot set
+type accessList struct {
+	addresses map[ethcmn.Address]int
+	slots     []map[ethcmn.Hash]struct{}
+}
+
+func newAccessList() *accessList {
+	return &accessList{
+		addresses: make(map[ethcmn.Address]int),
+	}
+}
+
+// contains returns whether the address and the slot are in the list
+func (al *accessList) contains(address ethcmn.Address, slot ethcmn.Hash) (addressOk bool, slotOk bool) {
+	idx, ok := al.addresses[address]
+	if !ok {
+		return false, false
+	}
+	if idx == -1 {
+		return true, false
+	}
+	_, slotOk = al.slots[idx][slot]
+	return true, slotOk
+}
+
+// addAddress adds an address to the list, it returns whether the address was added
+func (al *accessList) addAddress(address ethcmn.Address) bool {
+	if _, present := al.addresses[address]; present {
+		return false
+	}
+	al.addresses[address] = -1
+	return true
+}
+
+// addSlot adds a slot and its address to the list, it returns whether each was added
+func (al *accessList) addSlot(address ethcmn.Address, slot ethcmn.Hash) (addrChange bool, slotChange bool) {
+	idx, addrPresent := al.addresses[address]
+	if !addrPresent || idx == -1 {
+		al.addresses[address] = len(al.slots)
+		al.slots = append(al.slots, map[ethcmn.Hash]struct{}{slot: {}})
+		return !addrPresent, true
+	}
+
+	if _, ok := al.slots[idx][slot]; ok {
+		return false, false
+	}
+	al.slots[idx][slot] = struct{}{}
+	return false, true
+}
+
+// deleteSlot removes a slot added by addSlot, it is only called by the journal to revert
+// the additions in the reverse order
+func (al *accessList) deleteSlot(address ethcmn.Address, slot ethcmn.Hash) {
+	idx, addrOk := al.addresses[address]
+	if !addrOk {
+		panic("reverting slot change, address not present in list")
+	}
+
+	slotmap := al.slots[idx]
+	delete(slotmap, slot)
+	// the slot map of the address was added with the slot, it is dropped with it
+	if len(slotmap) == 0 {
+		al.slots = al.slots[:idx]
+		al.addresses[address] = -1
+	}
+}
+
+// deleteAddress removes an address added by addAddress, it is only called by the journal
+func (al *accessList) deleteAddress(address ethcmn.Address) {
+	delete(al.addresses, address)
+}
+
+func (al *accessList) copy() *accessList {
+	cp := newAccessList()
+	for k, v := range al.addresses {
+		cp.addresses[k] = v
+	}
+	cp.slots = make([]map[ethcmn.Hash]struct{}, len(al.slots))
+	for i, slotMap := range al.slots {
+		newSlotmap := make(map[ethcmn.Hash]struct{}, len(slotMap))
+		for k := range slotMap {
+			newSlotmap[k] = struct{}{}
+		}
+		cp.slots[i] = newSlotmap
+	}
+	return cp
+}
+
+// PrepareAccessList clears the access list and warms the sender, the recipient or the
+// created contract, the precompiled contracts and the entries of the access list of the tx
+func (csdb *CommitStateDB) PrepareAccessList(sender sdk.AccAddress, dst sdk.AccAddress, precompiles []sdk.AccAddress, list AccessList) {
+	csdb.accessList = newAccessList()
+	csdb.AddAddressToAccessList(sender)
+	if !dst.Empty() {
+		csdb.AddAddressToAccessList(dst)
+	}
+	for _, addr := range precompiles {
+		csdb.AddAddressToAccessList(addr)
+	}
+	for _, tuple := range list {
+		csdb.AddAddressToAccessList(tuple.Address)
+		for _, key := range tuple.StorageKeys {
+			csdb.AddSlotToAccessList(tuple.Address, key)
+		}
+	}
+}
+
+// AddAddressToAccessList adds the address to the access list
+func (csdb *CommitStateDB) AddAddressToAccessList(addr sdk.AccAddress) {
+	address := ethcmn.BytesToAddress(addr)
+	if csdb.accessList.addAddress(address) {
+		csdb.journal.append(accessListAddAccountChange{address: address})
+	}
+}
+
+// AddSlotToAccessList adds the address and the slot to the access list
+func (csdb *CommitStateDB) AddSlotToAccessList(addr sdk.AccAddress, slot sdk.Hash) {
+	address := ethcmn.BytesToAddress(addr)
+	addrMod, slotMod := csdb.accessList.addSlot(address, ethcmn.Hash(slot))
+	if addrMod {
+		// the address is journaled before the slot so that reverting the slot finds it
+		csdb.journal.append(accessListAddAccountChange{address: address})
+	}
+	if slotMod {
+		csdb.journal.append(accessListAddSlotChange{address: address, slot: ethcmn.Hash(slot)})
+	}
+}
+
+// AddressInAccessList returns whether the address is in the access list
+func (csdb *CommitStateDB) AddressInAccessList(addr sdk.AccAddress) bool {
+	_, ok := csdb.accessList.addresses[ethcmn.BytesToAddress(addr)]
+	return ok
+}
+
+// SlotInAccessList returns whether the address and the slot are in the access list
+func (csdb *CommitStateDB) SlotInAccessList(addr sdk.AccAddress, slot sdk.Hash) (addressOk bool, slotOk bool) {
+	return csdb.accessList.contains(ethcmn.BytesToAddress(addr), ethcmn.Hash(slot))
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// EthChainID returns the numeric chain id exposed to Ethereum tooling for the given
+// tendermint chain id. A purely numeric chain id is used as is, otherwise the first
+// four bytes of its sha256 hash are used so the result stays a safe JSON integer.
+func EthChainID(chainID string) *big.Int {
+	if id, err := strconv.ParseUint(chainID, 10, 32); err == nil {
+		return new(big.Int).SetUint64(id)
+	}
+
+	h := crypto.Sha256([]byte(chainID))
+	return new(big.Int).SetUint64(uint64(binary.BigEndian.Uint32(h[:4])))
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -57,6 +60,29 @@ type (
 	touchChange struct {
 		account *sdk.AccAddress
 	}
+
+	// changes made outside of the vm by a native call
+	nativeCallChange struct{}
+
+	accountChange struct {
+		account *sdk.AccAddress
+		prev    *types.BaseAccount
+	}
+
+	// changes to the access list
+	accessListAddAccountChange struct {
+		address ethcmn.Address
+	}
+
+	accessListAddSlotChange struct {
+		address ethcmn.Address
+		slot    ethcmn.Hash
+	}
+
+	transientStorageChange struct {
+		address       ethcmn.Address
+		key, prevalue sdk.Hash
+	}
 )
 
 // createObjectChange
@@ -169,3 +195,50 @@ func (ch addPreimageChange) revert(s *CommitStateDB) {
 func (ch addPreimageChange) dirtied() *sdk.AccAddress {
 	return nil
 }
+
+// nativeCallChange
+func (ch nativeCallChange) revert(s *CommitStateDB) {
+	last := len(s.nativeCalls) - 1
+	s.ctx = s.nativeCalls[last].parent
+	s.nativeCalls = s.nativeCalls[:last]
+}
+
+func (ch nativeCallChange) dirtied() *sdk.AccAddress {
+	return nil
+}
+
+// accountChange
+func (ch accountChange) revert(s *CommitStateDB) {
+	s.stateObjects[ch.account.String()].account = ch.prev
+}
+
+func (ch accountChange) dirtied() *sdk.AccAddress {
+	return ch.account
+}
+
+// accessListAddAccountChange
+func (ch accessListAddAccountChange) revert(s *CommitStateDB) {
+	s.accessList.deleteAddress(ch.address)
+}
+
+func (ch accessListAddAccountChange) dirtied() *sdk.AccAddress {
+	return nil
+}
+
+// accessListAddSlotChange
+func (ch accessListAddSlotChange) revert(s *CommitStateDB) {
+	s.accessList.deleteSlot(ch.address, ch.slot)
+}
+
+func (ch accessListAddSlotChange) dirtied() *sdk.AccAddress {
+	return nil
+}
+
+// transientStorageChange
+func (ch transientStorageChange) revert(s *CommitStateDB) {
+	s.transientStorage.set(ch.address, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) dirtied() *sdk.AccAddress {
+	return nil
+}
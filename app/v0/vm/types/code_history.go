@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// operations recorded in the code history of a contract
+const (
+	CodeHistoryOperationCreate  = "create"
+	CodeHistoryOperationMigrate = "migrate"
+)
+
+// CodeHistoryEntry records the code a contract runs from a height on
+type CodeHistoryEntry struct {
+	Operation string   `json:"operation" yaml:"operation"`
+	CodeHash  sdk.Hash `json:"code_hash" yaml:"code_hash"`
+	Height    int64    `json:"height" yaml:"height"`
+}
+
+// NewCodeHistoryEntry returns a CodeHistoryEntry
+func NewCodeHistoryEntry(operation string, codeHash sdk.Hash, height int64) CodeHistoryEntry {
+	return CodeHistoryEntry{
+		Operation: operation,
+		CodeHash:  codeHash,
+		Height:    height,
+	}
+}
+
+// CodeHistory is the code history of a contract, oldest entry first
+type CodeHistory []CodeHistoryEntry
+
+func (h CodeHistory) String() string {
+	j, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", err)
+	}
+	return string(j)
+}
+
+// AppendCodeHistory records the code a contract runs from the height of the context on
+func (csdb *CommitStateDB) AppendCodeHistory(addr sdk.AccAddress, operation string, codeHash sdk.Hash) {
+	history := append(csdb.GetCodeHistory(addr), NewCodeHistoryEntry(operation, codeHash, csdb.ctx.BlockHeight()))
+	csdb.setCodeHistory(addr, history)
+}
+
+// GetCodeHistory returns the code history of a contract, nil if nothing was recorded for it
+func (csdb *CommitStateDB) GetCodeHistory(addr sdk.AccAddress) CodeHistory {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixCodeHistory)
+	d := store.Get(addr.Bytes())
+	if d == nil {
+		return nil
+	}
+
+	var history CodeHistory
+	if err := json.Unmarshal(d, &history); err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return nil
+	}
+
+	return history
+}
+
+func (csdb *CommitStateDB) setCodeHistory(addr sdk.AccAddress, history CodeHistory) {
+	d, err := json.Marshal(history)
+	if err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixCodeHistory)
+	store.Set(addr.Bytes(), d)
+}
+
+func (csdb *CommitStateDB) exportCodeHistories() map[string]CodeHistory {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixCodeHistory)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var histories map[string]CodeHistory
+	for ; iter.Valid(); iter.Next() {
+		var history CodeHistory
+		if err := json.Unmarshal(iter.Value(), &history); err != nil {
+			panic(err)
+		}
+
+		if histories == nil {
+			histories = make(map[string]CodeHistory)
+		}
+		histories[sdk.AccAddress(iter.Key()).String()] = history
+	}
+
+	return histories
+}
+
+func (csdb *CommitStateDB) importCodeHistories(histories map[string]CodeHistory) error {
+	for k, history := range histories {
+		addr, err := sdk.AccAddressFromBech32(k)
+		if err != nil {
+			return err
+		}
+		csdb.setCodeHistory(addr, history)
+	}
+
+	return nil
+}
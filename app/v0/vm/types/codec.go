@@ -1,12 +1,19 @@
 package types
 
 import (
+	govtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/gov/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 )
 
 // RegisterCodec - register the sdk message type
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgContract{}, "dip/MsgContract", nil)
+	cdc.RegisterConcrete(MsgSetContractMeta{}, "dip/MsgSetContractMeta", nil)
+	cdc.RegisterConcrete(MsgAddDeployer{}, "dip/MsgAddDeployer", nil)
+	cdc.RegisterConcrete(MsgRemoveDeployer{}, "dip/MsgRemoveDeployer", nil)
+	cdc.RegisterConcrete(MsgContractMigrate{}, "dip/MsgContractMigrate", nil)
+	cdc.RegisterConcrete(MsgUpdateContractAdmin{}, "dip/MsgUpdateContractAdmin", nil)
+	cdc.RegisterConcrete(MsgDepositRent{}, "dip/MsgDepositRent", nil)
 }
 
 // ModuleCdc - generic sealed codec to be used throughout this module
@@ -17,4 +24,13 @@ func init() {
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
+
+	// the messages can be executed by a governance proposal
+	govtypes.RegisterProposalMsgCodec(MsgContract{}, "dip/MsgContract")
+	govtypes.RegisterProposalMsgCodec(MsgSetContractMeta{}, "dip/MsgSetContractMeta")
+	govtypes.RegisterProposalMsgCodec(MsgAddDeployer{}, "dip/MsgAddDeployer")
+	govtypes.RegisterProposalMsgCodec(MsgRemoveDeployer{}, "dip/MsgRemoveDeployer")
+	govtypes.RegisterProposalMsgCodec(MsgContractMigrate{}, "dip/MsgContractMigrate")
+	govtypes.RegisterProposalMsgCodec(MsgUpdateContractAdmin{}, "dip/MsgUpdateContractAdmin")
+	govtypes.RegisterProposalMsgCodec(MsgDepositRent{}, "dip/MsgDepositRent")
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+type contextKey int // local to the vm module
+
+const (
+	contextKeyGasPrice contextKey = iota
+	contextKeyEthTxNonce
+	contextKeySignedNonces
+)
+
+// SignedNonces are the sequences the signers of a tx signed it with, by signer address
+type SignedNonces map[string]uint64
+
+// WithGasPrice - initialize Context with the gas price of the tx
+func WithGasPrice(ctx sdk.Context, gasPrice sdk.Int) sdk.Context {
+	return ctx.WithValue(contextKeyGasPrice, gasPrice)
+}
+
+// GetGasPrice - get the gas price of the tx from Context, false when the context
+// carries no tx, e.g. for queries
+func GetGasPrice(ctx sdk.Context) (sdk.Int, bool) {
+	v := ctx.Value(contextKeyGasPrice)
+	if v == nil {
+		return sdk.ZeroInt(), false
+	}
+	return v.(sdk.Int), true
+}
+
+// WithEthTxNonce - initialize Context with the nonce the Ethereum tx was signed with
+func WithEthTxNonce(ctx sdk.Context, nonce uint64) sdk.Context {
+	return ctx.WithValue(contextKeyEthTxNonce, nonce)
+}
+
+// GetEthTxNonce - get the nonce the Ethereum tx was signed with from Context, false when
+// the context carries no Ethereum tx
+func GetEthTxNonce(ctx sdk.Context) (uint64, bool) {
+	v := ctx.Value(contextKeyEthTxNonce)
+	if v == nil {
+		return 0, false
+	}
+	return v.(uint64), true
+}
+
+// WithSignedNonces - initialize Context with the sequences the signers of the tx signed it
+// with, before the ante handler increased them
+func WithSignedNonces(ctx sdk.Context, nonces SignedNonces) sdk.Context {
+	return ctx.WithValue(contextKeySignedNonces, nonces)
+}
+
+// TakeSignedNonce - get the sequence signer signed the tx of Context with and remove it, so
+// that only the first creation of the tx uses it. False when the context carries no tx, the
+// signer did not sign it or the sequence has been taken already
+func TakeSignedNonce(ctx sdk.Context, signer sdk.AccAddress) (uint64, bool) {
+	nonces, ok := ctx.Value(contextKeySignedNonces).(SignedNonces)
+	if !ok {
+		return 0, false
+	}
+
+	nonce, ok := nonces[signer.String()]
+	if ok {
+		delete(nonces, signer.String())
+	}
+	return nonce, ok
+}
+
+// GetTxHash - get the hash of the tx of Context the receipt and the logs are stored under,
+// the Ethereum one for an Ethereum tx
+func GetTxHash(ctx sdk.Context) []byte {
+	if _, ok := GetEthTxNonce(ctx); ok {
+		return EthTxHash(ctx.TxBytes()).Bytes()
+	}
+	return tmhash.Sum(ctx.TxBytes())
+}
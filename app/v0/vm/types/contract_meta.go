@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// ContractMeta is what is known of a contract besides its code: the account which
+// deployed it, the admin allowed to migrate its code if there is one and, when
+// submitted, its ABI and the source/compiler metadata
+type ContractMeta struct {
+	Deployer sdk.AccAddress `json:"deployer" yaml:"deployer"`
+	Admin    sdk.AccAddress `json:"admin,omitempty" yaml:"admin"`
+	ABI      string         `json:"abi,omitempty" yaml:"abi"`
+	Metadata string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+// NewContractMeta returns a new ContractMeta
+func NewContractMeta(deployer sdk.AccAddress, abiJSON, metadata string) ContractMeta {
+	return ContractMeta{
+		Deployer: deployer,
+		ABI:      abiJSON,
+		Metadata: metadata,
+	}
+}
+
+func (m ContractMeta) String() string {
+	j, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", err)
+	}
+	return string(j)
+}
+
+// ValidateABI checks that abiJSON is empty or a valid contract ABI
+func ValidateABI(abiJSON string) error {
+	if len(abiJSON) == 0 {
+		return nil
+	}
+
+	if _, err := abi.JSON(strings.NewReader(abiJSON)); err != nil {
+		return sdkerrors.Wrap(ErrInvalidABI, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+)
+
+// deployment modes, they select the accounts allowed to create contracts
+const (
+	// DeploymentModeOpen lets any account create contracts
+	DeploymentModeOpen = "open"
+	// DeploymentModeAllowList lets the accounts of the deployer allow-list create contracts
+	DeploymentModeAllowList = "allow_list"
+	// DeploymentModeGuardian lets the profilers of the guardian module create contracts
+	DeploymentModeGuardian = "guardian"
+)
+
+func validateDeploymentMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("DeploymentMode'type must be string: %T", i)
+	}
+
+	switch v {
+	case DeploymentModeOpen, DeploymentModeAllowList, DeploymentModeGuardian:
+		return nil
+	default:
+		return fmt.Errorf("invalid deployment mode: %s", v)
+	}
+}
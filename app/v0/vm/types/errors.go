@@ -22,4 +22,20 @@ var (
 	ErrInvalidJump              = sdkerrors.New(ModuleName, 15, "evm: invalid jump destination")
 	ErrGasUintOverflow          = sdkerrors.New(ModuleName, 16, "gas uint64 overflow")
 	ErrWrongCtx                 = sdkerrors.New(ModuleName, 17, "must be simulate mode when gas limit is 0")
+	ErrInvalidEthTx             = sdkerrors.New(ModuleName, 18, "invalid ethereum transaction")
+	ErrInvalidChainID           = sdkerrors.New(ModuleName, 19, "invalid chain id")
+	ErrNoReceipt                = sdkerrors.New(ModuleName, 20, "no receipt found")
+	ErrInvalidOpcode            = sdkerrors.New(ModuleName, 21, "evm: invalid opcode")
+	ErrStackUnderflow           = sdkerrors.New(ModuleName, 22, "evm: stack underflow")
+	ErrStackOverflow            = sdkerrors.New(ModuleName, 23, "evm: stack limit reached")
+	ErrInvalidABI               = sdkerrors.New(ModuleName, 24, "invalid contract abi")
+	ErrNoContractMeta           = sdkerrors.New(ModuleName, 25, "no contract metadata found")
+	ErrNotContractDeployer      = sdkerrors.New(ModuleName, 26, "not the deployer of the contract")
+	ErrGasPriceTooLow           = sdkerrors.New(ModuleName, 27, "gas price under the vm min gas price")
+	ErrDeploymentNotAllowed     = sdkerrors.New(ModuleName, 28, "contract deployment not allowed")
+	ErrNotProfiler              = sdkerrors.New(ModuleName, 29, "not a guardian profiler")
+	ErrNotContractAdmin         = sdkerrors.New(ModuleName, 30, "not the admin of the contract")
+	ErrContractFrozen           = sdkerrors.New(ModuleName, 31, "contract frozen, its storage rent deposit ran out")
+	ErrAccessListNotSupported   = sdkerrors.New(ModuleName, 32, "access lists are not supported before the berlin fork")
+	ErrContractHalted           = sdkerrors.New(ModuleName, 33, "contract halted by the guardians")
 )
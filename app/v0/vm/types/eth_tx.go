@@ -0,0 +1,404 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+var _ sdk.Tx = EthTx{}
+
+// EthTxData is the RLP payload of an Ethereum transaction
+type EthTxData struct {
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *ethcmn.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+
+	// EIP-155 signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// EthAccessListTxType is the type of the EIP-2930 transactions, their encoding is prefixed with it
+const EthAccessListTxType = 0x01
+
+// EthAccessTuple is an entry of the access list of an EIP-2930 transaction
+type EthAccessTuple struct {
+	Address     ethcmn.Address `json:"address"`
+	StorageKeys []ethcmn.Hash  `json:"storageKeys"`
+}
+
+// EthAccessList is the access list of an EIP-2930 transaction
+type EthAccessList []EthAccessTuple
+
+// ToAccessList converts the access list into the one of a MsgContract
+func (al EthAccessList) ToAccessList() AccessList {
+	if len(al) == 0 {
+		return nil
+	}
+
+	accessList := make(AccessList, len(al))
+	for i, tuple := range al {
+		keys := make([]sdk.Hash, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			keys[j] = sdk.Hash(key)
+		}
+		accessList[i] = AccessTuple{Address: tuple.Address.Bytes(), StorageKeys: keys}
+	}
+	return accessList
+}
+
+// ethAccessListTxData is the RLP payload of an EIP-2930 transaction, V is the parity of
+// the signature
+type ethAccessListTxData struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *ethcmn.Address `rlp:"nil"`
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   EthAccessList
+
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// EthTx is an RLP encoded, EIP-155 signed Ethereum transaction or an EIP-2930 one. It
+// carries a single MsgContract whose sender is recovered from the signature.
+type EthTx struct {
+	Data EthTxData
+
+	// Type is 0 for a legacy transaction, EthAccessListTxType for an EIP-2930 one which
+	// signs its chain id and carries AccessList
+	Type       uint8
+	AccessList EthAccessList
+
+	// filled in when the signature is recovered, set by NewEthAccessListTx for an
+	// EIP-2930 transaction
+	chainID *big.Int
+	pubKey  secp256k1.PubKeySecp256k1
+	from    sdk.AccAddress
+}
+
+// NewEthTx creates an unsigned Ethereum transaction, a nil recipient creates a contract
+func NewEthTx(nonce uint64, to sdk.AccAddress, amount *big.Int, gasLimit uint64, gasPrice *big.Int, payload []byte) EthTx {
+	data := EthTxData{
+		AccountNonce: nonce,
+		Price:        new(big.Int),
+		GasLimit:     gasLimit,
+		Amount:       new(big.Int),
+		Payload:      payload,
+		V:            new(big.Int),
+		R:            new(big.Int),
+		S:            new(big.Int),
+	}
+	if !to.Empty() {
+		recipient := ethcmn.BytesToAddress(to)
+		data.Recipient = &recipient
+	}
+	if amount != nil {
+		data.Amount.Set(amount)
+	}
+	if gasPrice != nil {
+		data.Price.Set(gasPrice)
+	}
+
+	return EthTx{Data: data}
+}
+
+// NewEthAccessListTx creates an unsigned EIP-2930 transaction for the given chain id
+func NewEthAccessListTx(chainID *big.Int, nonce uint64, to sdk.AccAddress, amount *big.Int, gasLimit uint64, gasPrice *big.Int,
+	payload []byte, accessList EthAccessList) EthTx {
+	tx := NewEthTx(nonce, to, amount, gasLimit, gasPrice, payload)
+	tx.Type = EthAccessListTxType
+	tx.AccessList = accessList
+	tx.chainID = new(big.Int).Set(chainID)
+	return tx
+}
+
+// EthTxHash returns the hash Ethereum knows an encoded transaction by, the keccak256 hash of
+// its encoding. The receipt and the logs of an Ethereum tx are stored under it.
+func EthTxHash(txBytes []byte) sdk.Hash {
+	return sdk.BytesToHash(ethcrypto.Keccak256(txBytes))
+}
+
+// DecodeEthTx decodes an RLP encoded Ethereum transaction and recovers its sender
+func DecodeEthTx(txBytes []byte) (EthTx, error) {
+	var tx EthTx
+	if err := tx.decode(txBytes); err != nil {
+		return tx, sdkerrors.Wrap(ErrInvalidEthTx, err.Error())
+	}
+
+	if err := tx.recoverSender(); err != nil {
+		return tx, err
+	}
+
+	return tx, nil
+}
+
+// decode decodes a legacy transaction or, from its type prefix, an EIP-2930 one
+func (tx *EthTx) decode(txBytes []byte) error {
+	if len(txBytes) == 0 || txBytes[0] != EthAccessListTxType {
+		return rlp.DecodeBytes(txBytes, &tx.Data)
+	}
+
+	var data ethAccessListTxData
+	if err := rlp.DecodeBytes(txBytes[1:], &data); err != nil {
+		return err
+	}
+
+	tx.Data = EthTxData{
+		AccountNonce: data.AccountNonce,
+		Price:        data.Price,
+		GasLimit:     data.GasLimit,
+		Recipient:    data.Recipient,
+		Amount:       data.Amount,
+		Payload:      data.Payload,
+		V:            data.V,
+		R:            data.R,
+		S:            data.S,
+	}
+	tx.Type = EthAccessListTxType
+	tx.AccessList = data.AccessList
+	tx.chainID = data.ChainID
+	return nil
+}
+
+// Encode returns the RLP encoding of the transaction, prefixed with its type for an
+// EIP-2930 transaction
+func (tx EthTx) Encode() ([]byte, error) {
+	if tx.Type != EthAccessListTxType {
+		return rlp.EncodeToBytes(tx.Data)
+	}
+
+	bz, err := rlp.EncodeToBytes(tx.accessListTxData())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{EthAccessListTxType}, bz...), nil
+}
+
+func (tx EthTx) accessListTxData() ethAccessListTxData {
+	return ethAccessListTxData{
+		ChainID:      tx.chainID,
+		AccountNonce: tx.Data.AccountNonce,
+		Price:        tx.Data.Price,
+		GasLimit:     tx.Data.GasLimit,
+		Recipient:    tx.Data.Recipient,
+		Amount:       tx.Data.Amount,
+		Payload:      tx.Data.Payload,
+		AccessList:   tx.AccessList,
+		V:            tx.Data.V,
+		R:            tx.Data.R,
+		S:            tx.Data.S,
+	}
+}
+
+// Sign signs the transaction for the given chain id following EIP-155, or EIP-2930 for
+// a transaction with an access list
+func (tx *EthTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
+	sig, err := ethcrypto.Sign(tx.SigHash(chainID).Bytes(), priv)
+	if err != nil {
+		return err
+	}
+
+	tx.Data.R = new(big.Int).SetBytes(sig[:32])
+	tx.Data.S = new(big.Int).SetBytes(sig[32:64])
+	if tx.Type == EthAccessListTxType {
+		tx.chainID = new(big.Int).Set(chainID)
+		tx.Data.V = new(big.Int).SetUint64(uint64(sig[64]))
+		return tx.recoverSender()
+	}
+	tx.Data.V = new(big.Int).SetUint64(uint64(sig[64]) + 35)
+	tx.Data.V.Add(tx.Data.V, new(big.Int).Mul(chainID, big.NewInt(2)))
+
+	return tx.recoverSender()
+}
+
+// SigHash returns the hash the sender signs, EIP-155 or EIP-2930 one
+func (tx EthTx) SigHash(chainID *big.Int) ethcmn.Hash {
+	if tx.Type == EthAccessListTxType {
+		bz, err := rlp.EncodeToBytes([]interface{}{
+			chainID,
+			tx.Data.AccountNonce,
+			tx.Data.Price,
+			tx.Data.GasLimit,
+			tx.Data.Recipient,
+			tx.Data.Amount,
+			tx.Data.Payload,
+			tx.AccessList,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return ethcrypto.Keccak256Hash([]byte{EthAccessListTxType}, bz)
+	}
+
+	bz, err := rlp.EncodeToBytes([]interface{}{
+		tx.Data.AccountNonce,
+		tx.Data.Price,
+		tx.Data.GasLimit,
+		tx.Data.Recipient,
+		tx.Data.Amount,
+		tx.Data.Payload,
+		chainID, uint(0), uint(0),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return ethcmn.BytesToHash(ethcrypto.Keccak256(bz))
+}
+
+// recoverSender derives the chain id from V and recovers the signer. The sender
+// address is the Ethereum one, the last 20 bytes of the keccak256 hash of the
+// public key, so that wallets see the account they signed with.
+func (tx *EthTx) recoverSender() error {
+	v, r, s := tx.Data.V, tx.Data.R, tx.Data.S
+	if v == nil || r == nil || s == nil {
+		return sdkerrors.Wrap(ErrInvalidEthTx, "missing signature")
+	}
+
+	var chainID, recID *big.Int
+	if tx.Type == EthAccessListTxType {
+		// the chain id is signed, v is the parity of the signature
+		if tx.chainID == nil {
+			return sdkerrors.Wrap(ErrInvalidEthTx, "missing chain id")
+		}
+		chainID, recID = tx.chainID, v
+	} else {
+		if v.BitLen() <= 8 && (v.Uint64() == 27 || v.Uint64() == 28) {
+			return sdkerrors.Wrap(ErrInvalidEthTx, "only EIP-155 replay protected transactions are supported")
+		}
+		if v.Cmp(big.NewInt(35)) < 0 {
+			return sdkerrors.Wrapf(ErrInvalidEthTx, "invalid signature value v %s", v)
+		}
+
+		chainID = new(big.Int).Sub(v, big.NewInt(35))
+		chainID.Div(chainID, big.NewInt(2))
+		recID = new(big.Int).Sub(v, big.NewInt(35))
+		recID.Sub(recID, new(big.Int).Mul(chainID, big.NewInt(2)))
+	}
+
+	if recID.BitLen() > 8 || !ethcrypto.ValidateSignatureValues(byte(recID.Uint64()), r, s, true) {
+		return sdkerrors.Wrap(ErrInvalidEthTx, "invalid signature values")
+	}
+
+	sig := make([]byte, 65)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):64], s.Bytes())
+	sig[64] = byte(recID.Uint64())
+
+	pub, err := ethcrypto.SigToPub(tx.SigHash(chainID).Bytes(), sig)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidEthTx, err.Error())
+	}
+
+	copy(tx.pubKey[:], ethcrypto.CompressPubkey(pub))
+	tx.from = sdk.AccAddress(ethcrypto.PubkeyToAddress(*pub).Bytes())
+	tx.chainID = chainID
+
+	return nil
+}
+
+// GetMsgs returns the MsgContract carried by the transaction
+func (tx EthTx) GetMsgs() []sdk.Msg {
+	var to sdk.AccAddress
+	if tx.Data.Recipient != nil {
+		to = sdk.AccAddress(tx.Data.Recipient.Bytes())
+	}
+
+	msg := NewMsgContract(tx.from, to, tx.Data.Payload, sdk.NewCoin(sdk.NativeTokenName, sdk.NewIntFromBigInt(tx.Data.Amount)))
+	return []sdk.Msg{msg.WithAccessList(tx.AccessList.ToAccessList())}
+}
+
+// ValidateBasic does a simple and lightweight validation check that doesn't
+// require access to any other information.
+func (tx EthTx) ValidateBasic() error {
+	if tx.from.Empty() {
+		return sdkerrors.Wrap(ErrInvalidEthTx, "sender has not been recovered")
+	}
+	if tx.Data.GasLimit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrGasLimitError, "gas limit must be positive")
+	}
+	if tx.Data.Price == nil || tx.Data.Price.Sign() < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "gas price must not be negative")
+	}
+	if tx.Data.Amount == nil || tx.Data.Amount.Sign() < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must not be negative")
+	}
+
+	return tx.GetMsgs()[0].ValidateBasic()
+}
+
+// GetGas returns the gas limit of the transaction
+func (tx EthTx) GetGas() uint64 {
+	return tx.Data.GasLimit
+}
+
+// GetFee returns gasPrice * gasLimit in the native token
+func (tx EthTx) GetFee() sdk.Coins {
+	fee := new(big.Int).Mul(tx.Data.Price, new(big.Int).SetUint64(tx.Data.GasLimit))
+	return sdk.NewCoins(sdk.NewCoin(sdk.NativeTokenName, sdk.NewIntFromBigInt(fee)))
+}
+
+// FeePayer returns the sender, who pays the fee
+func (tx EthTx) FeePayer() sdk.AccAddress {
+	return tx.from
+}
+
+// GetNonce returns the account nonce of the transaction
+func (tx EthTx) GetNonce() uint64 {
+	return tx.Data.AccountNonce
+}
+
+// GetChainID returns the chain id derived from the signature
+func (tx EthTx) GetChainID() *big.Int {
+	return tx.chainID
+}
+
+// GetPubKey returns the public key recovered from the signature
+func (tx EthTx) GetPubKey() secp256k1.PubKeySecp256k1 {
+	return tx.pubKey
+}
+
+// NewTxDecoder returns a decoder that accepts amino encoded StdTx as well as
+// RLP encoded Ethereum transactions, legacy or EIP-2930 ones
+func NewTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
+	stdTxDecoder := authtypes.DefaultTxDecoder(cdc)
+
+	return func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := stdTxDecoder(txBytes)
+		if err == nil {
+			return tx, nil
+		}
+
+		var ethTx EthTx
+		if ethTx.decode(txBytes) != nil {
+			// neither a StdTx nor an Ethereum tx, report the amino error
+			return nil, err
+		}
+
+		if err := ethTx.recoverSender(); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		return ethTx, nil
+	}
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestEthTxSignAndDecode(t *testing.T) {
+	priv, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var pubKey secp256k1.PubKeySecp256k1
+	copy(pubKey[:], ethcrypto.CompressPubkey(&priv.PublicKey))
+	from := sdk.AccAddress(ethcrypto.PubkeyToAddress(priv.PublicKey).Bytes())
+	to := sdk.AccAddress([]byte("to__________________"))
+	chainID := EthChainID("foochainid")
+
+	tx := NewEthTx(3, to, big.NewInt(10), 21000, big.NewInt(2), []byte("payload"))
+	require.NoError(t, tx.Sign(chainID, priv))
+
+	bz, err := tx.Encode()
+	require.NoError(t, err)
+
+	decoded, err := NewTxDecoder(codec.New())(bz)
+	require.NoError(t, err)
+
+	ethTx, ok := decoded.(EthTx)
+	require.True(t, ok)
+	require.NoError(t, ethTx.ValidateBasic())
+	require.Equal(t, chainID, ethTx.GetChainID())
+	require.Equal(t, uint64(3), ethTx.GetNonce())
+	require.Equal(t, from, ethTx.FeePayer())
+	require.Equal(t, pubKey, ethTx.GetPubKey())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 42000)), ethTx.GetFee())
+
+	msgs := ethTx.GetMsgs()
+	require.Len(t, msgs, 1)
+	require.Equal(t, NewMsgContract(from, to, []byte("payload"), sdk.NewInt64Coin(sdk.NativeTokenName, 10)), msgs[0])
+
+	// contract creation has no recipient
+	tx = NewEthTx(0, nil, nil, 21000, big.NewInt(1), []byte("code"))
+	require.NoError(t, tx.Sign(chainID, priv))
+	bz, err = tx.Encode()
+	require.NoError(t, err)
+	ethTx, err = DecodeEthTx(bz)
+	require.NoError(t, err)
+	require.True(t, ethTx.GetMsgs()[0].(MsgContract).To.Empty())
+}
+
+func TestEthTxDecodeGethSigned(t *testing.T) {
+	// the example transaction of EIP-155, signed with the private key 0x4646...46
+	bz := sdk.FromHex("f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025" +
+		"a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276" +
+		"a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")
+
+	ethTx, err := DecodeEthTx(bz)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), ethTx.GetChainID())
+	require.Equal(t, uint64(9), ethTx.GetNonce())
+	require.Equal(t, ethcmn.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F").Bytes(), ethTx.FeePayer().Bytes())
+
+	msg := ethTx.GetMsgs()[0].(MsgContract)
+	require.Equal(t, ethcmn.HexToAddress("0x3535353535353535353535353535353535353535").Bytes(), msg.To.Bytes())
+	require.Equal(t, "1000000000000000000", msg.Amount.Amount.String())
+}
+
+func TestEthTxDecodeInvalid(t *testing.T) {
+	priv, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	// pre EIP-155 signatures are rejected
+	tx := NewEthTx(0, nil, nil, 21000, big.NewInt(1), []byte("code"))
+	require.NoError(t, tx.Sign(big.NewInt(1), priv))
+	tx.Data.V = big.NewInt(27)
+	bz, err := tx.Encode()
+	require.NoError(t, err)
+	_, err = DecodeEthTx(bz)
+	require.Error(t, err)
+
+	// unsigned
+	tx = NewEthTx(0, nil, nil, 21000, big.NewInt(1), []byte("code"))
+	bz, err = tx.Encode()
+	require.NoError(t, err)
+	_, err = NewTxDecoder(codec.New())(bz)
+	require.Error(t, err)
+
+	// garbage
+	_, err = NewTxDecoder(codec.New())([]byte("garbage"))
+	require.Error(t, err)
+}
+
+func TestEthAccessListTxSignAndDecode(t *testing.T) {
+	priv, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var pubKey secp256k1.PubKeySecp256k1
+	copy(pubKey[:], ethcrypto.CompressPubkey(&priv.PublicKey))
+	from := sdk.AccAddress(ethcrypto.PubkeyToAddress(priv.PublicKey).Bytes())
+	to := sdk.AccAddress([]byte("to__________________"))
+	chainID := EthChainID("foochainid")
+	accessList := EthAccessList{{
+		Address:     ethcmn.BytesToAddress(to),
+		StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(0))},
+	}}
+
+	tx := NewEthAccessListTx(chainID, 1, to, big.NewInt(10), 30000, big.NewInt(2), []byte("payload"), accessList)
+	require.NoError(t, tx.Sign(chainID, priv))
+	require.True(t, tx.Data.V.Uint64() <= 1)
+
+	bz, err := tx.Encode()
+	require.NoError(t, err)
+	require.Equal(t, byte(EthAccessListTxType), bz[0])
+
+	decoded, err := NewTxDecoder(codec.New())(bz)
+	require.NoError(t, err)
+
+	ethTx, ok := decoded.(EthTx)
+	require.True(t, ok)
+	require.NoError(t, ethTx.ValidateBasic())
+	require.Equal(t, chainID, ethTx.GetChainID())
+	require.Equal(t, from, ethTx.FeePayer())
+	require.Equal(t, accessList, ethTx.AccessList)
+
+	msg := ethTx.GetMsgs()[0].(MsgContract)
+	require.Equal(t, AccessList{{Address: to, StorageKeys: []sdk.Hash{sdk.BigToHash(big.NewInt(0))}}}, msg.AccessList)
+	require.NoError(t, msg.ValidateBasic())
+
+	// the chain id is signed
+	tampered := ethTx
+	tampered.chainID = big.NewInt(1)
+	bz, err = tampered.Encode()
+	require.NoError(t, err)
+	ethTx, err = DecodeEthTx(bz)
+	require.NoError(t, err)
+	require.NotEqual(t, from, ethTx.FeePayer())
+}
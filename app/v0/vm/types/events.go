@@ -3,7 +3,20 @@ package types
 const (
 	EventTypeContractCreated = "contract_created"
 	EventTypeContractCalled  = "contract_called"
+	EventTypeContractFailed  = "contract_failed"
+	EventTypeSetContractMeta = "set_contract_meta"
+	EventTypeAddDeployer     = "add_deployer"
+	EventTypeRemoveDeployer  = "remove_deployer"
+	EventTypeContractMigrate = "contract_migrated"
+	EventTypeUpdateAdmin     = "update_contract_admin"
+	EventTypeDepositRent     = "deposit_rent"
+	EventTypeContractFrozen  = "contract_frozen"
 
 	AttributeKeyAddress    = "address"
+	AttributeKeyDeployer   = "deployer"
+	AttributeKeyCodeHash   = "code_hash"
+	AttributeKeyAdmin      = "admin"
+	AttributeKeyVMError    = "vm_error"
+	AttributeKeyReason     = "reason"
 	AttributeValueCategory = "vm"
 )
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"time"
+
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/exported"
+	guardiantypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/guardian/types"
+	stakingtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/staking/types"
+	supplyexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
@@ -11,9 +16,45 @@ type AccountKeeper interface {
 	RemoveAccount(ctx sdk.Context, acc exported.Account)
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
 	SetAccount(ctx sdk.Context, acc exported.Account)
+	IterateAccounts(ctx sdk.Context, process func(exported.Account) (stop bool))
 }
 
 // BankKeeper defines the expected bank keeper used for vm
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSendEnabled(ctx sdk.Context) bool
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
+
+// SupplyKeeper defines the expected supply keeper used for vm
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	GetTokenMetaByContract(ctx sdk.Context, addr sdk.AccAddress) (supplyexported.TokenMetaI, bool)
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used for vm
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error)
+	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int) (sdk.Dec, error)
+	Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, error)
+	BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+		sharesAmount sdk.Dec) (time.Time, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper used for vm
+type DistributionKeeper interface {
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+}
+
+// GuardianKeeper defines the expected guardian keeper the deployments are restricted and the
+// calls of the contracts halted with
+type GuardianKeeper interface {
+	GetProfiler(ctx sdk.Context, addr sdk.AccAddress) (guardiantypes.Guardian, bool)
+	IsRouteDisabled(ctx sdk.Context, target string) bool
 }
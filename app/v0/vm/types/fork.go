@@ -0,0 +1,29 @@
+package types
+
+// Fork is the release of Ethereum whose instruction set and gas rules the vm follows, it is
+// selected by the protocol version
+type Fork uint8
+
+const (
+	// ForkIstanbul prices the accesses to accounts and storage with the flat costs of Istanbul
+	ForkIstanbul Fork = iota
+	// ForkBerlin prices the first access to an account or a storage slot in a tx above the
+	// next ones (EIP-2929) and takes the access lists of the txs (EIP-2930)
+	ForkBerlin
+	// ForkCancun adds PUSH0, BASEFEE, MCOPY and the transient storage of TLOAD and TSTORE
+	ForkCancun
+)
+
+// String implements fmt.Stringer
+func (f Fork) String() string {
+	switch f {
+	case ForkIstanbul:
+		return "istanbul"
+	case ForkBerlin:
+		return "berlin"
+	case ForkCancun:
+		return "cancun"
+	default:
+		return "unknown"
+	}
+}
@@ -2,24 +2,30 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
 type (
-	// GenesisState vm genesis state, include params, vm storage, vm codes, vm logs
+	// GenesisState vm genesis state, include params, vm storage, vm codes, vm logs, contract metas
 	GenesisState struct {
-		Params  Params              `json:"params"`
-		Storage []Storage           `json:"storage"`
-		Codes   map[string]sdk.Code `json:"codes"`
-		VMLogs  VMLogs              `json:"vm_logs"`
+		Params        Params                  `json:"params"`
+		Storage       []Storage               `json:"storage"`
+		Codes         map[string]sdk.Code     `json:"codes"`
+		VMLogs        VMLogs                  `json:"vm_logs"`
+		ContractMetas map[string]ContractMeta `json:"contract_metas,omitempty"`
+		Deployers     []sdk.AccAddress        `json:"deployers,omitempty"`
+		CodeHistories map[string]CodeHistory  `json:"code_histories,omitempty"`
+		ContractRents map[string]ContractRent `json:"contract_rents,omitempty"`
 	}
 
-	// Storage vm storage of k, v pairs
+	// Storage vm storage of k, v pairs, with the slot the contract wrote if it was recorded
 	Storage struct {
 		Key   hexutil.Bytes `json:"k"`
 		Value hexutil.Bytes `json:"v"`
+		Slot  hexutil.Bytes `json:"s,omitempty"`
 	}
 
 	// VMLogs vm logs, include log index, logs which conclude k(txHash), v(logs) pairs
@@ -54,7 +60,61 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return validateVMCommonGasParams(data.Params.VMContractCreationGasParams)
+	if err := validateVMCommonGasParams(data.Params.VMContractCreationGasParams); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPrice(data.Params.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateStakingPrecompileGasParams(data.Params.StakingPrecompileGasParams); err != nil {
+		return err
+	}
+
+	if err := validatePrecompiles(data.Params.Precompiles); err != nil {
+		return err
+	}
+
+	if err := validateDeploymentMode(data.Params.DeploymentMode); err != nil {
+		return err
+	}
+
+	if err := validateStorageRentRate(data.Params.StorageRentRate); err != nil {
+		return err
+	}
+
+	for addr := range data.CodeHistories {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+	}
+
+	for addr, rent := range data.ContractRents {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if (rent.Deposit == sdk.Int{}) || rent.Deposit.IsNegative() {
+			return fmt.Errorf("invalid rent deposit of contract %s", addr)
+		}
+	}
+
+	for _, deployer := range data.Deployers {
+		if deployer.Empty() {
+			return fmt.Errorf("empty deployer address")
+		}
+	}
+
+	for addr, meta := range data.ContractMetas {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if err := ValidateABI(meta.ABI); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Equal judge GenesisState equal
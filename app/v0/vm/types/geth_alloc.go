@@ -0,0 +1,165 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// SnapshotToGethAlloc reads a snapshot into a go-ethereum genesis alloc. The balances are
+// taken as they are, in the smallest unit of the native token. The storage slots whose key
+// was not recorded can not be converted, their number is returned
+func SnapshotToGethAlloc(sr *SnapshotReader) (alloc core.GenesisAlloc, skipped int, err error) {
+	alloc = make(core.GenesisAlloc)
+	codes := make(map[string][]byte)
+	codeHashes := make(map[ethcmn.Address][]byte)
+
+	for {
+		record, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch r := record.(type) {
+		case SnapshotCode:
+			codes[string(r.Hash)] = r.Code
+		case SnapshotAccount:
+			addr := ethcmn.BytesToAddress(r.Address)
+			acc := alloc[addr]
+			acc.Nonce = r.Nonce
+			acc.Balance = r.Balance
+			alloc[addr] = acc
+			if len(r.CodeHash) > 0 {
+				codeHashes[addr] = r.CodeHash
+			}
+		case SnapshotStorage:
+			if len(r.Slot) == 0 {
+				skipped++
+				continue
+			}
+			addr := ethcmn.BytesToAddress(r.Address)
+			acc := alloc[addr]
+			if acc.Storage == nil {
+				acc.Storage = make(map[ethcmn.Hash]ethcmn.Hash)
+			}
+			acc.Storage[ethcmn.BytesToHash(r.Slot)] = ethcmn.Hash(r.Value)
+			alloc[addr] = acc
+		}
+	}
+
+	for addr, codeHash := range codeHashes {
+		code, ok := codes[string(codeHash)]
+		if !ok {
+			return nil, 0, fmt.Errorf("no code of hash %x in the snapshot for account %s", codeHash, addr.Hex())
+		}
+		acc := alloc[addr]
+		acc.Code = code
+		alloc[addr] = acc
+	}
+
+	// the balance of an account of go-ethereum is required
+	for addr, acc := range alloc {
+		if acc.Balance == nil {
+			acc.Balance = new(big.Int)
+			alloc[addr] = acc
+		}
+	}
+
+	return alloc, skipped, nil
+}
+
+// GethAllocToSnapshot writes a go-ethereum genesis alloc as a snapshot, the codes are
+// hashed with keccak256 as Ethereum does
+func GethAllocToSnapshot(alloc core.GenesisAlloc, sw *SnapshotWriter) error {
+	addrs := make([]ethcmn.Address, 0, len(alloc))
+	for addr := range alloc {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0 })
+
+	written := make(map[ethcmn.Hash]bool)
+	for _, addr := range addrs {
+		code := alloc[addr].Code
+		if len(code) == 0 {
+			continue
+		}
+		codeHash := ethcrypto.Keccak256Hash(code)
+		if written[codeHash] {
+			continue
+		}
+		if err := sw.Write(SnapshotCode{Hash: codeHash.Bytes(), Code: code}); err != nil {
+			return err
+		}
+		written[codeHash] = true
+	}
+
+	for _, addr := range addrs {
+		acc := alloc[addr]
+		var codeHash []byte
+		if len(acc.Code) > 0 {
+			codeHash = ethcrypto.Keccak256(acc.Code)
+		}
+		err := sw.Write(SnapshotAccount{
+			Address:  addr.Bytes(),
+			Nonce:    acc.Nonce,
+			Balance:  acc.Balance,
+			CodeHash: codeHash,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range addrs {
+		storage := alloc[addr].Storage
+		slots := make([]ethcmn.Hash, 0, len(storage))
+		for slot, value := range storage {
+			if value != (ethcmn.Hash{}) {
+				slots = append(slots, slot)
+			}
+		}
+		sort.Slice(slots, func(i, j int) bool { return bytes.Compare(slots[i].Bytes(), slots[j].Bytes()) < 0 })
+
+		for _, slot := range slots {
+			err := sw.Write(SnapshotStorage{
+				Address: addr.Bytes(),
+				Key:     StorageKey(sdk.AccAddress(addr.Bytes()), slot.Bytes()),
+				Value:   sdk.Hash(storage[slot]),
+				Slot:    slot.Bytes(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return sw.Close()
+}
+
+// ParseGethAlloc parses the alloc of a go-ethereum genesis file, or a bare alloc
+func ParseGethAlloc(bz []byte) (core.GenesisAlloc, error) {
+	var genesis struct {
+		Alloc core.GenesisAlloc `json:"alloc"`
+	}
+	if err := json.Unmarshal(bz, &genesis); err == nil && genesis.Alloc != nil {
+		return genesis.Alloc, nil
+	}
+
+	var alloc core.GenesisAlloc
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return nil, err
+	}
+	return alloc, nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	"github.com/Dipper-Labs/Dipper-Protocol/app/protocol"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
@@ -18,13 +20,41 @@ var (
 
 // KVStore key prefixes
 var (
-	KeyPrefixLogs      = []byte{0x01}
-	KeyPrefixLogsIndex = []byte{0x02}
-	KeyPrefixCode      = []byte{0x03}
-	KeyPrefixStorage   = []byte{0x04}
+	KeyPrefixLogs         = []byte{0x01}
+	KeyPrefixLogsIndex    = []byte{0x02}
+	KeyPrefixCode         = []byte{0x03}
+	KeyPrefixStorage      = []byte{0x04}
+	KeyPrefixReceipts     = []byte{0x05}
+	KeyPrefixContractMeta = []byte{0x06}
+	KeyPrefixDeployers    = []byte{0x07}
+	KeyPrefixCodeHistory  = []byte{0x08}
+	KeyPrefixContractRent = []byte{0x09}
+
+	KeyPrefixStoragePreimage = []byte{0x0a}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address sdk.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
+
+// StoragePreimagePrefix returns a prefix to iterate over the slots of a given account storage
+// by the key they are stored under
+func StoragePreimagePrefix(address sdk.Address) []byte {
+	return append(KeyPrefixStoragePreimage, address.Bytes()...)
+}
+
+// StorageKey returns the key a storage slot of a contract is stored under
+func StorageKey(address sdk.Address, slot []byte) sdk.Hash {
+	compositeKey := make([]byte, len(address.Bytes())+len(slot))
+
+	copy(compositeKey, address.Bytes())
+	copy(compositeKey[len(address.Bytes()):], slot)
+
+	return sdk.BytesToHash(crypto.Sha256(compositeKey))
+}
+
+// DeployerKey returns the key of an account of the deployer allow-list
+func DeployerKey(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployers, addr.Bytes()...)
+}
@@ -0,0 +1,233 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sort"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// sub prefixes of the log filter index, the index lives in the KeyPrefixLogsIndex store next
+// to LogIndexKey and maps the position of every log to the hash of the tx which holds it
+var (
+	LogHeightIndexPrefix  = []byte{0x01}
+	LogAddressIndexPrefix = []byte{0x02}
+	LogTopicIndexPrefix   = []byte{0x03}
+)
+
+// LogFilter selects the logs of a block range by contract address and topics
+type LogFilter struct {
+	FromBlock uint64           `json:"from_block" yaml:"from_block"`
+	ToBlock   uint64           `json:"to_block" yaml:"to_block"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	// Topics are matched by position, any of the hashes of a position matches and an
+	// empty position matches everything
+	Topics [][]sdk.Hash `json:"topics" yaml:"topics"`
+}
+
+// NewLogFilter returns a new LogFilter
+func NewLogFilter(fromBlock, toBlock uint64, addresses []sdk.AccAddress, topics [][]sdk.Hash) LogFilter {
+	return LogFilter{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: addresses,
+		Topics:    topics,
+	}
+}
+
+// Match reports whether the log satisfies the block range, address and topic criteria
+func (f LogFilter) Match(log *Log) bool {
+	if log.BlockNumber < f.FromBlock || log.BlockNumber > f.ToBlock {
+		return false
+	}
+
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addr := range f.Addresses {
+			if addr.Equals(log.Address) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, position := range f.Topics {
+		if len(position) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range position {
+			if topic == log.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterLogs returns the first limit logs matching the filter ordered by block and log
+// index, all of them when limit is 0. It scans the log filter index instead of loading the
+// logs of every tx of the block range.
+func (csdb *CommitStateDB) FilterLogs(filter LogFilter, limit int) []*Log {
+	logs := make([]*Log, 0)
+	if filter.FromBlock > filter.ToBlock {
+		return logs
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixLogsIndex)
+
+	// the log index is unique and grows with the height, the candidates of all the
+	// scanned prefixes are merged and ordered by it
+	candidates := make(map[uint64]sdk.Hash)
+	for _, p := range filter.indexPrefixes() {
+		start := append(copyPrefix(p), heightKey(filter.FromBlock)...)
+		end := sdk.PrefixEndBytes(p)
+		if filter.ToBlock != math.MaxUint64 {
+			end = append(copyPrefix(p), heightKey(filter.ToBlock+1)...)
+		}
+
+		iter := store.Iterator(start, end)
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()
+			candidates[binary.BigEndian.Uint64(key[len(key)-8:])] = sdk.BytesToHash(iter.Value())
+		}
+		iter.Close()
+	}
+
+	indexes := make([]uint64, 0, len(candidates))
+	for index := range candidates {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	txLogs := make(map[sdk.Hash][]*Log)
+	for _, index := range indexes {
+		if limit > 0 && len(logs) >= limit {
+			break
+		}
+
+		txHash := candidates[index]
+		lgs, ok := txLogs[txHash]
+		if !ok {
+			lgs = csdb.GetLogs(txHash)
+			txLogs[txHash] = lgs
+		}
+
+		for _, log := range lgs {
+			if log.Index == index {
+				if filter.Match(log) {
+					logs = append(logs, log)
+				}
+				break
+			}
+		}
+	}
+
+	return logs
+}
+
+// indexLogs adds the logs of a tx to the log filter index
+func (csdb *CommitStateDB) indexLogs(txHash sdk.Hash, logs []*Log) {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixLogsIndex)
+
+	for _, log := range logs {
+		store.Set(LogHeightIndexKey(log.BlockNumber, log.Index), txHash.Bytes())
+		store.Set(LogAddressIndexKey(log.Address, log.BlockNumber, log.Index), txHash.Bytes())
+		for i, topic := range log.Topics {
+			store.Set(LogTopicIndexKey(i, topic, log.BlockNumber, log.Index), txHash.Bytes())
+		}
+	}
+}
+
+// reindexLogs rebuilds the log filter index of the logs stored as json, used on genesis
+// import. Logs which can not be decoded are kept but left out of the index.
+func (csdb *CommitStateDB) reindexLogs(txHash sdk.Hash, d []byte) {
+	var logs []*Log
+	if err := json.Unmarshal(d, &logs); err != nil {
+		return
+	}
+
+	csdb.indexLogs(txHash, logs)
+}
+
+// indexPrefixes returns the index prefixes to scan for the filter. The addresses are the
+// most selective criteria, then the first topic position which is set, and the heights
+// when the filter only has a block range.
+func (f LogFilter) indexPrefixes() [][]byte {
+	var prefixes [][]byte
+	if len(f.Addresses) > 0 {
+		for _, addr := range f.Addresses {
+			prefixes = append(prefixes, logAddressIndexPrefix(addr))
+		}
+		return prefixes
+	}
+
+	for i, position := range f.Topics {
+		if len(position) == 0 {
+			continue
+		}
+		for _, topic := range position {
+			prefixes = append(prefixes, logTopicIndexPrefix(i, topic))
+		}
+		return prefixes
+	}
+
+	return [][]byte{LogHeightIndexPrefix}
+}
+
+// LogHeightIndexKey returns the key of a log in the height index
+func LogHeightIndexKey(height, index uint64) []byte {
+	return append(copyPrefix(LogHeightIndexPrefix), logPosition(height, index)...)
+}
+
+// LogAddressIndexKey returns the key of a log in the address index
+func LogAddressIndexKey(addr sdk.AccAddress, height, index uint64) []byte {
+	return append(logAddressIndexPrefix(addr), logPosition(height, index)...)
+}
+
+// LogTopicIndexKey returns the key of a log in the index of the topics at the given position
+func LogTopicIndexKey(position int, topic sdk.Hash, height, index uint64) []byte {
+	return append(logTopicIndexPrefix(position, topic), logPosition(height, index)...)
+}
+
+func logAddressIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(copyPrefix(LogAddressIndexPrefix), addr.Bytes()...)
+}
+
+func logTopicIndexPrefix(position int, topic sdk.Hash) []byte {
+	return append(append(copyPrefix(LogTopicIndexPrefix), byte(position)), topic.Bytes()...)
+}
+
+// logPosition encodes the height and the log index big endian so that the keys of an
+// index are ordered by block and by log
+func logPosition(height, index uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], height)
+	binary.BigEndian.PutUint64(bz[8:], index)
+	return bz
+}
+
+func heightKey(height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return bz
+}
+
+func copyPrefix(p []byte) []byte {
+	return append(make([]byte, 0, len(p)+64), p...)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestCommitStateDB_FilterLogs(t *testing.T) {
+	commitStateDB := buildCommitStateDB()
+	ctx := commitStateDB.ctx.WithLogger(log.NewNopLogger())
+
+	contractA := sdk.AccAddress([]byte("contract_a__________"))
+	contractB := sdk.AccAddress([]byte("contract_b__________"))
+	transfer := sdk.HexToHash("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approval := sdk.HexToHash("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	alice := sdk.BytesToHash([]byte("alice"))
+	bob := sdk.BytesToHash([]byte("bob"))
+
+	// one tx per block from height 1 to 4
+	txs := []struct {
+		contract sdk.AccAddress
+		topics   []sdk.Hash
+	}{
+		{contractA, []sdk.Hash{transfer, alice, bob}},
+		{contractB, []sdk.Hash{transfer, bob, alice}},
+		{contractA, []sdk.Hash{approval, alice, bob}},
+		{contractA, []sdk.Hash{transfer, bob, alice}},
+	}
+	for i, tx := range txs {
+		commitStateDB.WithContext(ctx.WithBlockHeight(int64(i + 1))).WithTxHash([]byte{byte(i + 1)})
+		commitStateDB.AddLog(&Log{Address: tx.contract, Topics: tx.topics, BlockNumber: uint64(i + 1)})
+		commitStateDB.Finalise(true)
+	}
+
+	cases := []struct {
+		filter   LogFilter
+		expected []uint64
+	}{
+		{NewLogFilter(0, math.MaxUint64, nil, nil), []uint64{1, 2, 3, 4}},
+		{NewLogFilter(2, 3, nil, nil), []uint64{2, 3}},
+		{NewLogFilter(4, 2, nil, nil), []uint64{}},
+		{NewLogFilter(0, 10, []sdk.AccAddress{contractA}, nil), []uint64{1, 3, 4}},
+		{NewLogFilter(0, 10, []sdk.AccAddress{contractA, contractB}, nil), []uint64{1, 2, 3, 4}},
+		{NewLogFilter(0, 10, nil, [][]sdk.Hash{{transfer}}), []uint64{1, 2, 4}},
+		{NewLogFilter(0, 10, nil, [][]sdk.Hash{{transfer, approval}}), []uint64{1, 2, 3, 4}},
+		{NewLogFilter(0, 10, nil, [][]sdk.Hash{nil, {bob}}), []uint64{2, 4}},
+		{NewLogFilter(0, 10, nil, [][]sdk.Hash{{transfer}, nil, {alice}}), []uint64{2, 4}},
+		{NewLogFilter(0, 10, []sdk.AccAddress{contractA}, [][]sdk.Hash{{transfer}, {bob}}), []uint64{4}},
+		{NewLogFilter(0, 3, []sdk.AccAddress{contractA}, [][]sdk.Hash{{transfer}, {bob}}), []uint64{}},
+		{NewLogFilter(0, 10, nil, [][]sdk.Hash{nil, nil, nil, {alice}}), []uint64{}},
+	}
+
+	for i, tc := range cases {
+		logs := commitStateDB.FilterLogs(tc.filter, 0)
+		heights := make([]uint64, len(logs))
+		for j, l := range logs {
+			heights[j] = l.BlockNumber
+		}
+		require.Equal(t, tc.expected, heights, "case %d", i)
+	}
+	// the scan stops at the limit
+	logs := commitStateDB.FilterLogs(NewLogFilter(0, 10, []sdk.AccAddress{contractA}, nil), 2)
+	require.Len(t, logs, 2)
+	require.Equal(t, uint64(3), logs[1].BlockNumber)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/exported"
+	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// MigrateCodeHashes rehashes the code of every contract with the hashing of the
+// state db, the code is moved to its new hash and the CodeHash of the account is
+// updated. It returns the number of migrated accounts.
+func (csdb *CommitStateDB) MigrateCodeHashes() (int, error) {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixCode)
+
+	var contracts []exported.Account
+	csdb.ak.IterateAccounts(csdb.ctx, func(acc exported.Account) bool {
+		if len(acc.GetCodeHash()) != 0 {
+			contracts = append(contracts, acc)
+		}
+		return false
+	})
+
+	// contracts may share their code, the old keys are deleted once all of them moved
+	var oldHashes [][]byte
+	newHashes := make(map[string]struct{})
+	for _, acc := range contracts {
+		oldHash := acc.GetCodeHash()
+		code := store.Get(oldHash)
+		if len(code) == 0 {
+			return 0, fmt.Errorf("failed to get code hash %x for address: %s", oldHash, acc.GetAddress())
+		}
+
+		newHash := csdb.HashCode(code)
+		store.Set(newHash.Bytes(), code)
+		acc.SetCodeHash(newHash.Bytes())
+		csdb.ak.SetAccount(csdb.ctx, acc)
+
+		oldHashes = append(oldHashes, oldHash)
+		newHashes[string(newHash.Bytes())] = struct{}{}
+	}
+
+	for _, hash := range oldHashes {
+		if _, ok := newHashes[string(hash)]; !ok {
+			store.Delete(hash)
+		}
+	}
+
+	// drop the cached objects, they hold the old hashes
+	csdb.stateObjects = make(map[string]*stateObject)
+	csdb.stateObjectsDirty = make(map[string]struct{})
+
+	return len(contracts), nil
+}
+
+// MigrateStorageBytes sets the storage footprint of every contract from the slots it
+// holds, the slots written before the storage rent was introduced were not counted. The
+// rent of the contracts holding storage is owed after the grace period. It returns the
+// number of contracts holding storage.
+func (csdb *CommitStateDB) MigrateStorageBytes() int {
+	var contracts []sdk.AccAddress
+	csdb.ak.IterateAccounts(csdb.ctx, func(acc exported.Account) bool {
+		if len(acc.GetCodeHash()) != 0 {
+			contracts = append(contracts, acc.GetAddress())
+		}
+		return false
+	})
+
+	n := 0
+	for _, addr := range contracts {
+		var slots uint64
+		iter := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storageKey), AddressStoragePrefix(addr))
+		for ; iter.Valid(); iter.Next() {
+			slots++
+		}
+		iter.Close()
+
+		rent := csdb.GetContractRent(addr)
+		if slots == 0 && rent.StorageBytes == 0 {
+			continue
+		}
+		if slots > 0 {
+			n++
+			rent.ChargedHeight = csdb.ctx.BlockHeight() + StorageRentGracePeriod
+		}
+
+		rent.StorageBytes = slots * StorageSlotBytes
+		csdb.SetContractRent(addr, rent)
+	}
+
+	return n
+}
@@ -7,12 +7,23 @@ import (
 )
 
 const (
-	TypeMsgContractCreate = "contract_create"
-	TypeMsgContractCall   = "contract_call"
+	TypeMsgContractCreate  = "contract_create"
+	TypeMsgContractCall    = "contract_call"
+	TypeMsgSetContractMeta = "set_contract_meta"
+	TypeMsgAddDeployer     = "add_deployer"
+	TypeMsgRemoveDeployer  = "remove_deployer"
+	TypeMsgContractMigrate = "contract_migrate"
+	TypeMsgUpdateAdmin     = "update_contract_admin"
+	TypeMsgDepositRent     = "deposit_rent"
 )
 
 var (
 	_ sdk.Msg = &MsgContract{}
+	_ sdk.Msg = &MsgSetContractMeta{}
+	_ sdk.Msg = &MsgAddDeployer{}
+	_ sdk.Msg = &MsgRemoveDeployer{}
+	_ sdk.Msg = &MsgContractMigrate{}
+	_ sdk.Msg = &MsgUpdateContractAdmin{}
 )
 
 type MsgContract struct {
@@ -20,6 +31,14 @@ type MsgContract struct {
 	To      sdk.AccAddress `json:"to" yaml:"to"`
 	Payload hexutil.Bytes  `json:"payload" yaml:"payload"`
 	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+
+	// ABI and Metadata are stored next to the code of the created contract
+	ABI      string `json:"abi,omitempty" yaml:"abi"`
+	Metadata string `json:"metadata,omitempty" yaml:"metadata"`
+	// Admin can migrate the code of the created contract, no one can without
+	Admin sdk.AccAddress `json:"admin,omitempty" yaml:"admin"`
+	// AccessList are the accounts and storage slots warm from the start of the execution
+	AccessList AccessList `json:"access_list,omitempty" yaml:"access_list"`
 }
 
 func (msg MsgContract) Route() string {
@@ -47,8 +66,17 @@ func (msg MsgContract) ValidateBasic() error {
 	if len(msg.Payload) == 0 {
 		return ErrNoPayload
 	}
+	if !msg.To.Empty() && (len(msg.ABI) != 0 || len(msg.Metadata) != 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "abi and metadata can only be submitted with a contract creation")
+	}
+	if !msg.To.Empty() && !msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "admin can only be set with a contract creation")
+	}
+	if err := msg.AccessList.ValidateBasic(); err != nil {
+		return err
+	}
 
-	return nil
+	return ValidateABI(msg.ABI)
 }
 
 func (msg MsgContract) GetSignBytes() []byte {
@@ -69,6 +97,25 @@ func NewMsgContract(from, to sdk.AccAddress, payload []byte, amount sdk.Coin) Ms
 	}
 }
 
+// WithContractMeta returns msg with the ABI and metadata to store for the created contract
+func (msg MsgContract) WithContractMeta(abiJSON, metadata string) MsgContract {
+	msg.ABI = abiJSON
+	msg.Metadata = metadata
+	return msg
+}
+
+// WithAdmin returns msg with the admin allowed to migrate the code of the created contract
+func (msg MsgContract) WithAdmin(admin sdk.AccAddress) MsgContract {
+	msg.Admin = admin
+	return msg
+}
+
+// WithAccessList returns msg with the accounts and storage slots warm from the start of the execution
+func (msg MsgContract) WithAccessList(accessList AccessList) MsgContract {
+	msg.AccessList = accessList
+	return msg
+}
+
 type MsgContractQuery MsgContract
 
 func NewMsgContractQuery(from, to sdk.AccAddress, payload []byte, amount sdk.Coin) MsgContractQuery {
@@ -79,3 +126,269 @@ func NewMsgContractQuery(from, to sdk.AccAddress, payload []byte, amount sdk.Coi
 		Amount:  amount,
 	}
 }
+
+// MsgSetContractMeta sets the ABI and metadata of a contract, only its deployer or admin can send it.
+// A guardian profiler records them for the contracts created before they were recorded.
+// An empty ABI or Metadata leaves the stored one unchanged.
+type MsgSetContractMeta struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	ABI      string         `json:"abi" yaml:"abi"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+}
+
+func NewMsgSetContractMeta(from, contract sdk.AccAddress, abiJSON, metadata string) MsgSetContractMeta {
+	return MsgSetContractMeta{
+		From:     from,
+		Contract: contract,
+		ABI:      abiJSON,
+		Metadata: metadata,
+	}
+}
+
+func (msg MsgSetContractMeta) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractMeta) Type() string {
+	return TypeMsgSetContractMeta
+}
+
+func (msg MsgSetContractMeta) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing contract address")
+	}
+	if len(msg.ABI) == 0 && len(msg.Metadata) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "abi and metadata are both empty")
+	}
+
+	return ValidateABI(msg.ABI)
+}
+
+func (msg MsgSetContractMeta) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetContractMeta) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// MsgAddDeployer adds an account to the deployer allow-list, only a guardian profiler can send it
+type MsgAddDeployer struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Deployer sdk.AccAddress `json:"deployer" yaml:"deployer"`
+}
+
+func NewMsgAddDeployer(from, deployer sdk.AccAddress) MsgAddDeployer {
+	return MsgAddDeployer{
+		From:     from,
+		Deployer: deployer,
+	}
+}
+
+func (msg MsgAddDeployer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAddDeployer) Type() string {
+	return TypeMsgAddDeployer
+}
+
+func (msg MsgAddDeployer) ValidateBasic() error {
+	return validateDeployerMsg(msg.From, msg.Deployer)
+}
+
+func (msg MsgAddDeployer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAddDeployer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// MsgRemoveDeployer removes an account from the deployer allow-list, only a guardian profiler can send it
+type MsgRemoveDeployer struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Deployer sdk.AccAddress `json:"deployer" yaml:"deployer"`
+}
+
+func NewMsgRemoveDeployer(from, deployer sdk.AccAddress) MsgRemoveDeployer {
+	return MsgRemoveDeployer{
+		From:     from,
+		Deployer: deployer,
+	}
+}
+
+func (msg MsgRemoveDeployer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveDeployer) Type() string {
+	return TypeMsgRemoveDeployer
+}
+
+func (msg MsgRemoveDeployer) ValidateBasic() error {
+	return validateDeployerMsg(msg.From, msg.Deployer)
+}
+
+func (msg MsgRemoveDeployer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRemoveDeployer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func validateDeployerMsg(from, deployer sdk.AccAddress) error {
+	if from.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	if deployer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing deployer address")
+	}
+	return nil
+}
+
+// MsgContractMigrate replaces the code of a contract and keeps its storage, only the admin
+// of the contract can send it
+type MsgContractMigrate struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Code     hexutil.Bytes  `json:"code" yaml:"code"`
+}
+
+func NewMsgContractMigrate(from, contract sdk.AccAddress, code []byte) MsgContractMigrate {
+	return MsgContractMigrate{
+		From:     from,
+		Contract: contract,
+		Code:     code,
+	}
+}
+
+func (msg MsgContractMigrate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgContractMigrate) Type() string {
+	return TypeMsgContractMigrate
+}
+
+func (msg MsgContractMigrate) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing contract address")
+	}
+	if len(msg.Code) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg missing code")
+	}
+	return nil
+}
+
+func (msg MsgContractMigrate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgContractMigrate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// MsgUpdateContractAdmin transfers the admin rights of a contract, an empty NewAdmin clears
+// them and makes the code of the contract final. Only the admin of the contract can send it
+type MsgUpdateContractAdmin struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	NewAdmin sdk.AccAddress `json:"new_admin,omitempty" yaml:"new_admin"`
+}
+
+func NewMsgUpdateContractAdmin(from, contract, newAdmin sdk.AccAddress) MsgUpdateContractAdmin {
+	return MsgUpdateContractAdmin{
+		From:     from,
+		Contract: contract,
+		NewAdmin: newAdmin,
+	}
+}
+
+func (msg MsgUpdateContractAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateContractAdmin) Type() string {
+	return TypeMsgUpdateAdmin
+}
+
+func (msg MsgUpdateContractAdmin) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing contract address")
+	}
+	return nil
+}
+
+func (msg MsgUpdateContractAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUpdateContractAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// MsgDepositRent tops up the deposit the storage rent of a contract is paid from, any
+// account can send it
+type MsgDepositRent struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Amount   sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgDepositRent(from, contract sdk.AccAddress, amount sdk.Coin) MsgDepositRent {
+	return MsgDepositRent{
+		From:     from,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+func (msg MsgDepositRent) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDepositRent) Type() string {
+	return TypeMsgDepositRent
+}
+
+func (msg MsgDepositRent) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing from address")
+	}
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "msg missing contract address")
+	}
+	if msg.Amount.Denom != sdk.NativeTokenName {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "rent is paid in %s", sdk.NativeTokenName)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg MsgDepositRent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgDepositRent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
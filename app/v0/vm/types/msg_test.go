@@ -9,6 +9,8 @@ import (
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
 
+const testABI = `[{"inputs":[],"name":"get","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
 func TestMsgContract(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
 	addr2 := sdk.AccAddress([]byte("to"))
@@ -49,6 +51,13 @@ func TestMsgContract(t *testing.T) {
 		{false, NewMsgContract(addr1, addr2, nil, coin123)},
 		{false, NewMsgContract(addr1, addr2, payloadEmpty, coin123)},
 		{false, NewMsgContract(emptyAddr, addr2, payload, coin123)},
+
+		// abi and metadata
+		{true, NewMsgContract(addr1, nil, payload, coin0).WithContractMeta(testABI, "metadata")},
+		{true, NewMsgContract(addr1, nil, payload, coin0).WithContractMeta("", "metadata")},
+		{false, NewMsgContract(addr1, nil, payload, coin0).WithContractMeta("not an abi", "")},
+		{false, NewMsgContract(addr1, addr2, payload, coin0).WithContractMeta(testABI, "")},
+		{false, NewMsgContract(addr1, addr2, payload, coin0).WithContractMeta("", "metadata")},
 	}
 
 	for _, tc := range cases {
@@ -88,6 +97,88 @@ func TestMsgContractGetSignBytes(t *testing.T) {
 
 }
 
+func TestMsgSetContractMeta(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("contract"))
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgSetContractMeta
+	}{
+		{true, NewMsgSetContractMeta(addr1, addr2, testABI, "metadata")},
+		{true, NewMsgSetContractMeta(addr1, addr2, testABI, "")},
+		{true, NewMsgSetContractMeta(addr1, addr2, "", "metadata")},
+		{false, NewMsgSetContractMeta(addr1, addr2, "", "")},
+		{false, NewMsgSetContractMeta(addr1, addr2, "not an abi", "")},
+		{false, NewMsgSetContractMeta(emptyAddr, addr2, testABI, "")},
+		{false, NewMsgSetContractMeta(addr1, emptyAddr, testABI, "")},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgSetContractMeta(addr1, addr2, "", "metadata")
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgSetContractMeta, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgDeployer(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	deployer := sdk.AccAddress([]byte("deployer"))
+
+	require.NoError(t, NewMsgAddDeployer(from, deployer).ValidateBasic())
+	require.Error(t, NewMsgAddDeployer(nil, deployer).ValidateBasic())
+	require.Error(t, NewMsgAddDeployer(from, nil).ValidateBasic())
+	require.Equal(t, TypeMsgAddDeployer, NewMsgAddDeployer(from, deployer).Type())
+
+	require.NoError(t, NewMsgRemoveDeployer(from, deployer).ValidateBasic())
+	require.Error(t, NewMsgRemoveDeployer(nil, deployer).ValidateBasic())
+	require.Error(t, NewMsgRemoveDeployer(from, nil).ValidateBasic())
+	require.Equal(t, TypeMsgRemoveDeployer, NewMsgRemoveDeployer(from, deployer).Type())
+	require.Equal(t, []sdk.AccAddress{from}, NewMsgRemoveDeployer(from, deployer).GetSigners())
+}
+
+func TestMsgContractMigrate(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	contract := sdk.AccAddress([]byte("contract"))
+
+	require.NoError(t, NewMsgContractMigrate(from, contract, []byte{0}).ValidateBasic())
+	require.Error(t, NewMsgContractMigrate(nil, contract, []byte{0}).ValidateBasic())
+	require.Error(t, NewMsgContractMigrate(from, nil, []byte{0}).ValidateBasic())
+	require.Error(t, NewMsgContractMigrate(from, contract, nil).ValidateBasic())
+	require.Equal(t, TypeMsgContractMigrate, NewMsgContractMigrate(from, contract, []byte{0}).Type())
+
+	// an empty admin clears the admin rights
+	require.NoError(t, NewMsgUpdateContractAdmin(from, contract, nil).ValidateBasic())
+	require.Error(t, NewMsgUpdateContractAdmin(nil, contract, from).ValidateBasic())
+	require.Error(t, NewMsgUpdateContractAdmin(from, nil, from).ValidateBasic())
+	require.Equal(t, TypeMsgUpdateAdmin, NewMsgUpdateContractAdmin(from, contract, from).Type())
+
+	// the admin is set on creation only
+	require.Error(t, NewMsgContract(from, contract, []byte{0}, sdk.NewInt64Coin(sdk.NativeTokenName, 0)).WithAdmin(from).ValidateBasic())
+}
+
+func TestMsgDepositRent(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	contract := sdk.AccAddress([]byte("contract"))
+	amount := sdk.NewInt64Coin(sdk.NativeTokenName, 10)
+
+	require.NoError(t, NewMsgDepositRent(from, contract, amount).ValidateBasic())
+	require.Error(t, NewMsgDepositRent(nil, contract, amount).ValidateBasic())
+	require.Error(t, NewMsgDepositRent(from, nil, amount).ValidateBasic())
+	require.Error(t, NewMsgDepositRent(from, contract, sdk.NewInt64Coin(sdk.NativeTokenName, 0)).ValidateBasic())
+	require.Error(t, NewMsgDepositRent(from, contract, sdk.NewInt64Coin("stake", 10)).ValidateBasic())
+	require.Equal(t, TypeMsgDepositRent, NewMsgDepositRent(from, contract, amount).Type())
+}
+
 func TestMsgContractGetSigners(t *testing.T) {
 	var msg = NewMsgContract(sdk.AccAddress([]byte("from")), nil, []byte("payload"), sdk.NewInt64Coin(sdk.NativeTokenName, 123))
 	res := msg.GetSigners()
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// nativeCall is a cache branch of the context the writes of a native call go to
+type nativeCall struct {
+	parent sdk.Context
+	ctx    sdk.Context
+	write  func()
+}
+
+// Context returns the context the state is read from, it includes the writes of
+// the native calls which are not flushed yet
+func (csdb *CommitStateDB) Context() sdk.Context {
+	return csdb.ctx
+}
+
+// NativeCall runs fn, which writes through the keepers of other modules, on a cache
+// branch of the context. The accounts modified by the vm so far are written to the
+// branch first so that fn sees them, and the accounts fn modified are reloaded into
+// the state objects afterwards. The branch is journaled: it is dropped when the state
+// is reverted to a snapshot taken before the call and flushed on Finalise or Commit.
+// Nothing is kept when fn fails.
+func (csdb *CommitStateDB) NativeCall(fn func(ctx sdk.Context) error) error {
+	branch, write := csdb.ctx.CacheContext()
+
+	for addr := range csdb.journal.dirties {
+		so, exist := csdb.stateObjects[addr]
+		if !exist || so.deleted || so.suicided {
+			continue
+		}
+		csdb.ak.SetAccount(branch, so.storedAccount())
+	}
+
+	if err := fn(branch); err != nil {
+		return err
+	}
+
+	csdb.journal.append(nativeCallChange{})
+	csdb.nativeCalls = append(csdb.nativeCalls, nativeCall{parent: csdb.ctx, ctx: branch, write: write})
+	csdb.ctx = branch
+
+	for _, so := range csdb.stateObjects {
+		if so.deleted {
+			continue
+		}
+		acc, _, ok := baseAccount(csdb.ak.GetAccount(branch, so.address))
+		if !ok {
+			continue
+		}
+		if acc.Sequence != so.account.Sequence || !coinsEqual(acc.Coins, so.account.Coins) {
+			csdb.journal.append(accountChange{account: &so.address, prev: so.account})
+			so.account = acc
+		}
+	}
+
+	return nil
+}
+
+// flushNativeCalls writes the branches of the native calls into the context they were
+// made on and emits their events there
+func (csdb *CommitStateDB) flushNativeCalls() {
+	if len(csdb.nativeCalls) == 0 {
+		return
+	}
+
+	for i := len(csdb.nativeCalls) - 1; i >= 0; i-- {
+		csdb.nativeCalls[i].write()
+	}
+
+	base := csdb.nativeCalls[0].parent
+	for _, call := range csdb.nativeCalls {
+		base.EventManager().EmitEvents(call.ctx.EventManager().Events())
+	}
+
+	csdb.ctx = base
+	csdb.nativeCalls = nil
+}
+
+// GetCoinBalance returns the amount of denom held by an account
+func (csdb *CommitStateDB) GetCoinBalance(addr sdk.AccAddress, denom string) *big.Int {
+	if so := csdb.stateObjects[addr.String()]; so != nil && !so.deleted {
+		return so.account.Coins.AmountOf(denom).BigInt()
+	}
+
+	// the account is not loaded into a state object, which only takes base accounts
+	if acc := csdb.ak.GetAccount(csdb.ctx, addr); acc != nil {
+		return acc.GetCoins().AmountOf(denom).BigInt()
+	}
+
+	return zeroBalance
+}
+
+// coinsEqual compares coins of any denoms, unlike sdk.Coins.IsEqual it does not panic
+func coinsEqual(a, b sdk.Coins) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Denom != b[i].Denom || !a[i].Amount.Equal(b[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
@@ -13,6 +13,18 @@ const (
 
 	defaultContractCreationGas = 53000
 	defaultGasPerByte          = 200
+
+	// DefaultMinGasPrice leaves the gas price threshold of auth as the only lower bound
+	DefaultMinGasPrice = 0
+
+	defaultDelegateGas        = 60000
+	defaultUndelegateGas      = 60000
+	defaultRedelegateGas      = 80000
+	defaultWithdrawRewardsGas = 40000
+	defaultStakingQueryGas    = 2000
+
+	// DefaultStorageRentRate leaves the storage free of rent
+	DefaultStorageRentRate = 0
 )
 
 // nolint
@@ -21,6 +33,11 @@ var (
 	KeyMaxCallCreateDepth          = []byte("MaxCallCreateDepth")
 	KeyVMOpGasParams               = []byte("VMOpGasParams")
 	KeyVMContractCreationGasParams = []byte("VMContractCreationGasParams")
+	KeyMinGasPrice                 = []byte("MinGasPrice")
+	KeyStakingPrecompileGasParams  = []byte("StakingPrecompileGasParams")
+	KeyPrecompiles                 = []byte("Precompiles")
+	KeyDeploymentMode              = []byte("DeploymentMode")
+	KeyStorageRentRate             = []byte("StorageRentRate")
 
 	DefaultVMOpGasParams = [256]uint64{
 		0, 3, 5, 3, 5, 5, 5, 5, 8, 8, 0, 5, 0, 0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 0, //0-31
@@ -34,6 +51,14 @@ var (
 	}
 
 	vmContractCreationGasParams = VMContractCreationGasParams{Gas: defaultContractCreationGas, GasPerByte: defaultGasPerByte}
+
+	DefaultStakingPrecompileGasParams = StakingPrecompileGasParams{
+		Delegate:        defaultDelegateGas,
+		Undelegate:      defaultUndelegateGas,
+		Redelegate:      defaultRedelegateGas,
+		WithdrawRewards: defaultWithdrawRewardsGas,
+		Query:           defaultStakingQueryGas,
+	}
 )
 
 // VMContractCreationGasParams contract creation gas params
@@ -42,22 +67,43 @@ type VMContractCreationGasParams struct {
 	GasPerByte uint64 `json:"gas_per_byte" yaml:"gas_per_byte"`
 }
 
+// StakingPrecompileGasParams is the gas charged by the methods of the staking and
+// distribution precompiled contracts
+type StakingPrecompileGasParams struct {
+	Delegate        uint64 `json:"delegate" yaml:"delegate"`
+	Undelegate      uint64 `json:"undelegate" yaml:"undelegate"`
+	Redelegate      uint64 `json:"redelegate" yaml:"redelegate"`
+	WithdrawRewards uint64 `json:"withdraw_rewards" yaml:"withdraw_rewards"`
+	Query           uint64 `json:"query" yaml:"query"`
+}
+
 type Params struct {
 	MaxCodeSize                 uint64                      `json:"max_code_size" yaml:"max_code_size"`
 	MaxCallCreateDepth          uint64                      `json:"max_call_create_depth" yaml:"max_call_create_depth"`
 	VMOpGasParams               [256]uint64                 `json:"vm_op_gas_params" yaml:"vm_op_gas_params"`
 	VMContractCreationGasParams VMContractCreationGasParams `json:"vm_contract_creation_gas_params" yaml:"vm_contract_creation_gas_params"`
+	MinGasPrice                 uint64                      `json:"min_gas_price" yaml:"min_gas_price"`
+	StakingPrecompileGasParams  StakingPrecompileGasParams  `json:"staking_precompile_gas_params" yaml:"staking_precompile_gas_params"`
+	Precompiles                 []Precompile                `json:"precompiles" yaml:"precompiles"`
+	DeploymentMode              string                      `json:"deployment_mode" yaml:"deployment_mode"`
+	StorageRentRate             uint64                      `json:"storage_rent_rate" yaml:"storage_rent_rate"`
 }
 
 var _ params.ParamSet = (*Params)(nil)
 
 // NewParams return Params
-func NewParams(maxCodeSize, callCreateDepth uint64, vmOpGasParams [256]uint64, vmContractCreationGasParams VMContractCreationGasParams) Params {
+func NewParams(maxCodeSize, callCreateDepth uint64, vmOpGasParams [256]uint64, vmContractCreationGasParams VMContractCreationGasParams, minGasPrice uint64,
+	stakingPrecompileGasParams StakingPrecompileGasParams, precompiles []Precompile, deploymentMode string, storageRentRate uint64) Params {
 	return Params{
 		MaxCodeSize:                 maxCodeSize,
 		MaxCallCreateDepth:          callCreateDepth,
 		VMOpGasParams:               vmOpGasParams,
 		VMContractCreationGasParams: vmContractCreationGasParams,
+		MinGasPrice:                 minGasPrice,
+		StakingPrecompileGasParams:  stakingPrecompileGasParams,
+		Precompiles:                 precompiles,
+		DeploymentMode:              deploymentMode,
+		StorageRentRate:             storageRentRate,
 	}
 }
 
@@ -67,6 +113,11 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyMaxCallCreateDepth, &p.MaxCallCreateDepth, validateMaxCallCreateDepth),
 		params.NewParamSetPair(KeyVMOpGasParams, &p.VMOpGasParams, validateVMOpGasParams),
 		params.NewParamSetPair(KeyVMContractCreationGasParams, &p.VMContractCreationGasParams, validateVMCommonGasParams),
+		params.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
+		params.NewParamSetPair(KeyStakingPrecompileGasParams, &p.StakingPrecompileGasParams, validateStakingPrecompileGasParams),
+		params.NewParamSetPair(KeyPrecompiles, &p.Precompiles, validatePrecompiles),
+		params.NewParamSetPair(KeyDeploymentMode, &p.DeploymentMode, validateDeploymentMode),
+		params.NewParamSetPair(KeyStorageRentRate, &p.StorageRentRate, validateStorageRentRate),
 	}
 }
 
@@ -76,6 +127,11 @@ func DefaultParams() Params {
 		defaultCallCreateDepth,
 		DefaultVMOpGasParams,
 		vmContractCreationGasParams,
+		DefaultMinGasPrice,
+		DefaultStakingPrecompileGasParams,
+		DefaultPrecompiles(),
+		DeploymentModeOpen,
+		DefaultStorageRentRate,
 	)
 }
 
@@ -126,3 +182,37 @@ func validateVMCommonGasParams(i interface{}) error {
 
 	return nil
 }
+
+func validateMinGasPrice(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("MinGasPrice'type must be uint64: %T", i)
+	}
+
+	return nil
+}
+
+// validateStorageRentRate checks the rent charged per storage byte per block, zero leaves
+// the storage free of rent
+func validateStorageRentRate(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("StorageRentRate'type must be uint64: %T", i)
+	}
+
+	return nil
+}
+
+func validateStakingPrecompileGasParams(i interface{}) error {
+	v, ok := i.(StakingPrecompileGasParams)
+	if !ok {
+		return fmt.Errorf("invalid type: %T", i)
+	}
+
+	// the state changing methods must not be free
+	if v.Delegate == 0 || v.Undelegate == 0 || v.Redelegate == 0 || v.WithdrawRewards == 0 {
+		return fmt.Errorf("gas of the staking precompiled contracts must be positive: %+v", v)
+	}
+
+	return nil
+}
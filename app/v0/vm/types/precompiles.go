@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// implementation ids of the precompiled contracts
+const (
+	PrecompileEcrecover      = "ecrecover"
+	PrecompileSha256         = "sha256"
+	PrecompileRipemd160      = "ripemd160"
+	PrecompileIdentity       = "identity"
+	PrecompileModExp         = "modexp"
+	PrecompileBn256Add       = "bn256_add"
+	PrecompileBn256ScalarMul = "bn256_scalar_mul"
+	PrecompileBn256Pairing   = "bn256_pairing"
+	PrecompileBlake2F        = "blake2f"
+	PrecompileStaking        = "staking"
+	PrecompileDistribution   = "distribution"
+)
+
+// PrecompileImplementations are the implementation ids a precompiled contract can be registered with
+var PrecompileImplementations = []string{
+	PrecompileEcrecover,
+	PrecompileSha256,
+	PrecompileRipemd160,
+	PrecompileIdentity,
+	PrecompileModExp,
+	PrecompileBn256Add,
+	PrecompileBn256ScalarMul,
+	PrecompileBn256Pairing,
+	PrecompileBlake2F,
+	PrecompileStaking,
+	PrecompileDistribution,
+}
+
+// Precompile is an entry of the precompiled contract registry, the contract of Implementation
+// runs at Address from ActivationHeight on as long as it is enabled
+type Precompile struct {
+	Address          sdk.AccAddress `json:"address" yaml:"address"`
+	Implementation   string         `json:"implementation" yaml:"implementation"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+	ActivationHeight int64          `json:"activation_height" yaml:"activation_height"`
+}
+
+// NewPrecompile returns a Precompile
+func NewPrecompile(addr sdk.AccAddress, implementation string, enabled bool, activationHeight int64) Precompile {
+	return Precompile{
+		Address:          addr,
+		Implementation:   implementation,
+		Enabled:          enabled,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// IsActive returns whether the precompiled contract runs at height
+func (p Precompile) IsActive(height int64) bool {
+	return p.Enabled && height >= p.ActivationHeight
+}
+
+// ActivePrecompileAddresses returns the addresses of the precompiled contracts running at height
+func ActivePrecompileAddresses(precompiles []Precompile, height int64) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	for _, p := range precompiles {
+		if p.IsActive(height) {
+			addrs = append(addrs, p.Address)
+		}
+	}
+	return addrs
+}
+
+// PrecompileAddress returns the 20 bytes address of the precompiled contract numbered n
+func PrecompileAddress(n ...byte) sdk.AccAddress {
+	return ethcmn.BytesToAddress(n).Bytes()
+}
+
+// DefaultPrecompiles are the contracts of the Istanbul release followed by the staking and
+// distribution contracts, all active from genesis
+func DefaultPrecompiles() []Precompile {
+	return []Precompile{
+		NewPrecompile(PrecompileAddress(1), PrecompileEcrecover, true, 0),
+		NewPrecompile(PrecompileAddress(2), PrecompileSha256, true, 0),
+		NewPrecompile(PrecompileAddress(3), PrecompileRipemd160, true, 0),
+		NewPrecompile(PrecompileAddress(4), PrecompileIdentity, true, 0),
+		NewPrecompile(PrecompileAddress(5), PrecompileModExp, true, 0),
+		NewPrecompile(PrecompileAddress(6), PrecompileBn256Add, true, 0),
+		NewPrecompile(PrecompileAddress(7), PrecompileBn256ScalarMul, true, 0),
+		NewPrecompile(PrecompileAddress(8), PrecompileBn256Pairing, true, 0),
+		NewPrecompile(PrecompileAddress(9), PrecompileBlake2F, true, 0),
+		NewPrecompile(PrecompileAddress(1, 0), PrecompileStaking, true, 0),
+		NewPrecompile(PrecompileAddress(1, 1), PrecompileDistribution, true, 0),
+	}
+}
+
+func validatePrecompiles(i interface{}) error {
+	v, ok := i.([]Precompile)
+	if !ok {
+		return fmt.Errorf("invalid type: %T", i)
+	}
+
+	known := make(map[string]bool, len(PrecompileImplementations))
+	for _, id := range PrecompileImplementations {
+		known[id] = true
+	}
+
+	// the call opcodes strip the leading zeros of an address, addresses are compared padded
+	seen := make(map[ethcmn.Address]bool, len(v))
+	for _, p := range v {
+		if p.Address.Empty() || len(p.Address) > sdk.AddrLen {
+			return fmt.Errorf("invalid precompiled contract address: %s", p.Address)
+		}
+		addr := ethcmn.BytesToAddress(p.Address)
+		if seen[addr] {
+			return fmt.Errorf("duplicate precompiled contract address: %s", p.Address)
+		}
+		seen[addr] = true
+
+		if !known[p.Implementation] {
+			return fmt.Errorf("unknown precompiled contract implementation: %s", p.Implementation)
+		}
+		if p.ActivationHeight < 0 {
+			return fmt.Errorf("activation height of precompiled contract %s must not be negative: %d", p.Address, p.ActivationHeight)
+		}
+	}
+
+	return nil
+}
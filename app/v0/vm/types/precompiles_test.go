@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestValidatePrecompiles(t *testing.T) {
+	require.NoError(t, validatePrecompiles(DefaultPrecompiles()))
+
+	tests := []struct {
+		name        string
+		precompiles []Precompile
+	}{
+		{"empty address", []Precompile{NewPrecompile(nil, PrecompileSha256, true, 0)}},
+		{"long address", []Precompile{NewPrecompile(make(sdk.AccAddress, sdk.AddrLen+1), PrecompileSha256, true, 0)}},
+		{"unknown implementation", []Precompile{NewPrecompile(PrecompileAddress(2), "sha3", true, 0)}},
+		{"negative activation height", []Precompile{NewPrecompile(PrecompileAddress(2), PrecompileSha256, true, -1)}},
+		{"duplicate address", []Precompile{
+			NewPrecompile(PrecompileAddress(2), PrecompileSha256, true, 0),
+			NewPrecompile(sdk.AccAddress{2}, PrecompileRipemd160, false, 0),
+		}},
+	}
+	for _, tc := range tests {
+		require.Error(t, validatePrecompiles(tc.precompiles), tc.name)
+	}
+
+	p := NewPrecompile(PrecompileAddress(2), PrecompileSha256, true, 10)
+	require.False(t, p.IsActive(9))
+	require.True(t, p.IsActive(10))
+	p.Enabled = false
+	require.False(t, p.IsActive(10))
+}
@@ -8,13 +8,20 @@ import (
 )
 
 const (
-	QueryParameters = "params"
-	QueryState      = "state"
-	QueryCode       = "code"
-	QueryStorage    = "storage"
-	QueryTxLogs     = "logs"
-	EstimateGas     = "estimate_gas"
-	QueryCall       = "call"
+	QueryParameters   = "params"
+	QueryState        = "state"
+	QueryCode         = "code"
+	QueryStorage      = "storage"
+	QueryTxLogs       = "logs"
+	QueryReceipt      = "receipt"
+	QueryTrace        = "trace"
+	QueryContractMeta = "contract_meta"
+	QueryDeployers    = "deployers"
+	QueryCodeHistory  = "code_history"
+	QueryContractRent = "contract_rent"
+	QueryFilterLogs   = "filter_logs"
+	EstimateGas       = "estimate_gas"
+	QueryCall         = "call"
 )
 
 // QueryLogsResult - for query logs
@@ -35,14 +42,23 @@ func (q QueryStorageResult) String() string {
 	return q.Value.String()
 }
 
-// SimulationResult - for Gas Estimate
+// QueryDeployersResult - for query the deployer allow-list
+type QueryDeployersResult []sdk.AccAddress
+
+func (q QueryDeployersResult) String() string {
+	return fmt.Sprintf("%v", []sdk.AccAddress(q))
+}
+
+// SimulationResult - for Gas Estimate, GasPrice is the lowest gas price the tx is
+// accepted with
 type SimulationResult struct {
-	Gas uint64
-	Res string
+	Gas      uint64
+	GasPrice uint64
+	Res      string
 }
 
 func (r SimulationResult) String() string {
-	return fmt.Sprintf("Gas = %d\nRes = %s", r.Gas, r.Res)
+	return fmt.Sprintf("Gas = %d\nGasPrice = %d\nRes = %s", r.Gas, r.GasPrice, r.Res)
 }
 
 // VMQueryResult
@@ -64,3 +80,25 @@ type QueryStateParams struct {
 	ShowCode     bool `json:"show_code" yaml:"show_code"`
 	ContractOnly bool `json:"contract_only" yaml:"contract_only"`
 }
+
+// QueryFilterLogsParams - for query the logs of a block range by address and topics, a
+// non-positive block means the latest one
+type QueryFilterLogsParams struct {
+	FromBlock int64            `json:"from_block" yaml:"from_block"`
+	ToBlock   int64            `json:"to_block" yaml:"to_block"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Topics    [][]sdk.Hash     `json:"topics" yaml:"topics"`
+	Page      int              `json:"page" yaml:"page"`
+	Limit     int              `json:"limit" yaml:"limit"`
+}
+
+func NewQueryFilterLogsParams(fromBlock, toBlock int64, addresses []sdk.AccAddress, topics [][]sdk.Hash, page, limit int) QueryFilterLogsParams {
+	return QueryFilterLogsParams{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: addresses,
+		Topics:    topics,
+		Page:      page,
+		Limit:     limit,
+	}
+}
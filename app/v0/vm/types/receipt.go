@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+const (
+	// ReceiptStatusFailed is the status of a receipt whose execution failed
+	ReceiptStatusFailed = uint64(0)
+	// ReceiptStatusSuccessful is the status of a receipt whose execution succeeded
+	ReceiptStatusSuccessful = uint64(1)
+
+	// BloomByteLength is the number of bytes of the 2048 bit logs bloom
+	BloomByteLength = 256
+)
+
+// Bloom is the 2048 bit logs bloom filter, computed the same way as in Ethereum
+type Bloom [BloomByteLength]byte
+
+// Add adds data to the bloom
+func (b *Bloom) Add(data []byte) {
+	h := ethcrypto.Keccak256(data)
+	for i := 0; i < 6; i += 2 {
+		bit := (uint(h[i])<<8 | uint(h[i+1])) & 2047
+		b[BloomByteLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test reports whether data may have been added to the bloom
+func (b Bloom) Test(data []byte) bool {
+	var t Bloom
+	t.Add(data)
+	for i := range t {
+		if t[i]&b[i] != t[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes returns the bloom as a byte slice
+func (b Bloom) Bytes() []byte {
+	return b[:]
+}
+
+// MarshalJSON encodes the bloom as a hex string
+func (b Bloom) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.Bytes(b[:]))
+}
+
+// UnmarshalJSON decodes a hex string into the bloom
+func (b *Bloom) UnmarshalJSON(input []byte) error {
+	var bz hexutil.Bytes
+	if err := json.Unmarshal(input, &bz); err != nil {
+		return err
+	}
+	if len(bz) != BloomByteLength {
+		return fmt.Errorf("invalid bloom length %d", len(bz))
+	}
+	copy(b[:], bz)
+	return nil
+}
+
+// MarshalYAML encodes the bloom as a hex string
+func (b Bloom) MarshalYAML() (interface{}, error) {
+	return hexutil.Encode(b[:]), nil
+}
+
+// CreateBloom returns the bloom of the addresses and topics of the logs
+func CreateBloom(logs []*Log) (b Bloom) {
+	for _, log := range logs {
+		b.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			b.Add(topic.Bytes())
+		}
+	}
+	return
+}
+
+// Receipt is the result of a vm transaction
+type Receipt struct {
+	TxHash            sdk.Hash       `json:"transactionHash" yaml:"transactionHash"`
+	BlockNumber       uint64         `json:"blockNumber" yaml:"blockNumber"`
+	Status            uint64         `json:"status" yaml:"status"`
+	From              sdk.AccAddress `json:"from" yaml:"from"`
+	To                sdk.AccAddress `json:"to" yaml:"to"`
+	ContractAddress   sdk.AccAddress `json:"contractAddress" yaml:"contractAddress"`
+	GasUsed           uint64         `json:"gasUsed" yaml:"gasUsed"`
+	CumulativeGasUsed uint64         `json:"cumulativeGasUsed" yaml:"cumulativeGasUsed"`
+	Logs              []*Log         `json:"logs" yaml:"logs"`
+	Bloom             Bloom          `json:"logsBloom" yaml:"logsBloom"`
+}
+
+func (r Receipt) String() string {
+	j, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", err)
+	}
+	return string(j)
+}
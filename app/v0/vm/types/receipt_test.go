@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestCreateBloom(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	topic := sdk.BytesToHash([]byte("topic"))
+
+	bloom := CreateBloom([]*Log{{Address: addr, Topics: []sdk.Hash{topic}}})
+	require.True(t, bloom.Test(addr.Bytes()))
+	require.True(t, bloom.Test(topic.Bytes()))
+	require.False(t, bloom.Test(sdk.BytesToHash([]byte("other")).Bytes()))
+	require.Equal(t, Bloom{}, CreateBloom(nil))
+
+	// each item sets at most three bits
+	var b Bloom
+	b.Add(nil)
+	bits := 0
+	for _, x := range b {
+		for ; x > 0; x &= x - 1 {
+			bits++
+		}
+	}
+	require.True(t, bits > 0 && bits <= 3)
+}
+
+func TestReceiptJSON(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	logs := []*Log{{Address: addr, Topics: []sdk.Hash{sdk.BytesToHash([]byte("topic"))}, Data: []byte{}}}
+	receipt := Receipt{
+		TxHash:            sdk.BytesToHash([]byte("tx")),
+		BlockNumber:       10,
+		Status:            ReceiptStatusSuccessful,
+		From:              addr,
+		ContractAddress:   addr,
+		GasUsed:           100,
+		CumulativeGasUsed: 200,
+		Logs:              logs,
+		Bloom:             CreateBloom(logs),
+	}
+
+	bz, err := json.Marshal(receipt)
+	require.NoError(t, err)
+
+	var res Receipt
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, receipt.Bloom, res.Bloom)
+	require.Equal(t, receipt.ContractAddress, res.ContractAddress)
+	require.Equal(t, receipt.CumulativeGasUsed, res.CumulativeGasUsed)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+// vm error classes reported in the events of a failed vm tx
+const (
+	VMErrorClassOutOfGas            = "out_of_gas"
+	VMErrorClassRevert              = "revert"
+	VMErrorClassInvalidOpcode       = "invalid_opcode"
+	VMErrorClassDepth               = "depth"
+	VMErrorClassInsufficientBalance = "insufficient_balance"
+	VMErrorClassOther               = "other"
+)
+
+var (
+	// errorSelector is the selector of the Error(string) error raised by a revert with a reason
+	errorSelector = ethcrypto.Keccak256([]byte("Error(string)"))[:4]
+
+	// panicSelector is the selector of the Panic(uint256) error raised by solidity >= 0.8 on failed asserts
+	panicSelector = ethcrypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panicReasons are the descriptions of the solidity panic codes
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+// PackRevertReason encodes a revert reason the way solidity does, as an Error(string) payload
+func PackRevertReason(reason string) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	bz, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		panic(err)
+	}
+	return append(append([]byte{}, errorSelector...), bz...)
+}
+
+// UnpackRevertReason decodes the Error(string) or Panic(uint256) payload returned by a
+// reverted execution, the second return value is false if the payload is neither of them
+func UnpackRevertReason(data []byte) (string, bool) {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+
+	if len(data) != 4+32 || !bytes.Equal(data[:4], panicSelector) {
+		return "", false
+	}
+
+	code := new(big.Int).SetBytes(data[4:])
+	if code.IsUint64() {
+		if reason, ok := panicReasons[code.Uint64()]; ok {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code), true
+		}
+	}
+	return fmt.Sprintf("panic: unknown code 0x%x", code), true
+}
+
+// VMErrorClass returns the class of an error returned by the vm
+func VMErrorClass(err error) string {
+	switch {
+	case ErrOutOfGas.Is(err), ErrCodeStoreOutOfGas.Is(err), ErrGasUintOverflow.Is(err):
+		return VMErrorClassOutOfGas
+	case ErrExecutionReverted.Is(err):
+		return VMErrorClassRevert
+	case ErrInvalidOpcode.Is(err), ErrInvalidJump.Is(err), ErrStackUnderflow.Is(err),
+		ErrStackOverflow.Is(err), ErrWriteProtection.Is(err), ErrReturnDataOutOfBounds.Is(err):
+		return VMErrorClassInvalidOpcode
+	case ErrDepth.Is(err):
+		return VMErrorClassDepth
+	case ErrInsufficientBalance.Is(err):
+		return VMErrorClassInsufficientBalance
+	default:
+		return VMErrorClassOther
+	}
+}
+
+// ErrorFromVMError adds the failing contract and, for a revert, the decoded reason to a vm
+// error while keeping its code
+func ErrorFromVMError(err error, contract sdk.AccAddress, ret []byte) error {
+	if ErrExecutionReverted.Is(err) {
+		if reason, ok := UnpackRevertReason(ret); ok {
+			return sdkerrors.Wrapf(err, "contract %s, reason: %s", contract, reason)
+		}
+	}
+	return sdkerrors.Wrapf(err, "contract %s", contract)
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	sdkerrors "github.com/Dipper-Labs/Dipper-Protocol/types/errors"
+)
+
+func TestUnpackRevertReason(t *testing.T) {
+	cases := []struct {
+		data     string
+		expected string
+		ok       bool
+	}{
+		// Error("insufficient balance")
+		{"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000014" +
+			"696e73756666696369656e742062616c616e6365000000000000000000000000", "insufficient balance", true},
+		// Panic(0x11)
+		{"4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011", "panic: arithmetic underflow or overflow (0x11)", true},
+		// Panic(0x99)
+		{"4e487b71" + "0000000000000000000000000000000000000000000000000000000000000099", "panic: unknown code 0x99", true},
+		{"4e487b71", "", false},
+		{"deadbeef", "", false},
+		{"", "", false},
+	}
+
+	for i, tc := range cases {
+		reason, ok := UnpackRevertReason(sdk.FromHex(tc.data))
+		require.Equal(t, tc.ok, ok, "case %d", i)
+		require.Equal(t, tc.expected, reason, "case %d", i)
+	}
+}
+
+func TestVMErrorClass(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected string
+	}{
+		{ErrOutOfGas, VMErrorClassOutOfGas},
+		{sdkerrors.Wrap(ErrOutOfGas, "wrapped"), VMErrorClassOutOfGas},
+		{ErrCodeStoreOutOfGas, VMErrorClassOutOfGas},
+		{ErrExecutionReverted, VMErrorClassRevert},
+		{sdkerrors.Wrapf(ErrInvalidOpcode, "0x%x", 0xfe), VMErrorClassInvalidOpcode},
+		{ErrInvalidJump, VMErrorClassInvalidOpcode},
+		{ErrDepth, VMErrorClassDepth},
+		{ErrInsufficientBalance, VMErrorClassInsufficientBalance},
+		{ErrContractAddressCollision, VMErrorClassOther},
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, tc.expected, VMErrorClass(tc.err), "case %d", i)
+	}
+}
+
+func TestErrorFromVMError(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	ret := sdk.FromHex("4e487b71" + "0000000000000000000000000000000000000000000000000000000000000001")
+
+	err := ErrorFromVMError(ErrExecutionReverted, contract, ret)
+	require.True(t, ErrExecutionReverted.Is(err))
+	require.True(t, strings.Contains(err.Error(), contract.String()))
+	require.True(t, strings.Contains(err.Error(), "assert(false)"))
+
+	space, code, _ := sdkerrors.ABCIInfo(err, false)
+	require.Equal(t, ModuleName, space)
+	require.Equal(t, ErrExecutionReverted.ABCICode(), code)
+
+	err = ErrorFromVMError(ErrOutOfGas, contract, ret)
+	require.True(t, ErrOutOfGas.Is(err))
+	require.False(t, strings.Contains(err.Error(), "assert(false)"))
+}
+
+func TestPackRevertReason(t *testing.T) {
+	reason, ok := UnpackRevertReason(PackRevertReason("insufficient allowance"))
+	require.True(t, ok)
+	require.Equal(t, "insufficient allowance", reason)
+}
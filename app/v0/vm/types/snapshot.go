@@ -0,0 +1,347 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// A snapshot is a header followed by chunks of records and an end chunk:
+//
+//	header: magic (8 bytes) | height (int64)
+//	chunk:  payload length (uint32) | record count (uint32) | payload | sha256(payload)
+//	end:    0 (uint32) | 0 (uint32) | sha256 of the checksums of the chunks
+//
+// A record is its kind followed by its fields, the byte fields are prefixed with their
+// uvarint length. The integers are big endian.
+var snapshotMagic = []byte("DIPVMSS1")
+
+const (
+	// DefaultSnapshotChunkSize is the payload size a chunk is flushed at
+	DefaultSnapshotChunkSize = 1 << 20
+
+	// the largest chunk a reader accepts, a record is not split across chunks
+	maxSnapshotChunkSize = 64 << 20
+
+	snapshotRecordAccount byte = 1
+	snapshotRecordCode    byte = 2
+	snapshotRecordStorage byte = 3
+)
+
+// SnapshotRecord is a record of a snapshot, a SnapshotAccount, a SnapshotCode or a SnapshotStorage
+type SnapshotRecord interface {
+	snapshotRecordKind() byte
+}
+
+// SnapshotAccount is an account of a snapshot, its balance is in the native token
+type SnapshotAccount struct {
+	Address  sdk.AccAddress
+	Nonce    uint64
+	Balance  *big.Int
+	CodeHash []byte
+}
+
+// SnapshotCode is a contract code of a snapshot by the hash the accounts refer to it with
+type SnapshotCode struct {
+	Hash []byte
+	Code []byte
+}
+
+// SnapshotStorage is a storage slot of a contract. Key is the key the slot is stored under,
+// Slot the key the contract wrote it with, empty if it was not recorded
+type SnapshotStorage struct {
+	Address sdk.AccAddress
+	Key     sdk.Hash
+	Value   sdk.Hash
+	Slot    []byte
+}
+
+func (SnapshotAccount) snapshotRecordKind() byte { return snapshotRecordAccount }
+func (SnapshotCode) snapshotRecordKind() byte    { return snapshotRecordCode }
+func (SnapshotStorage) snapshotRecordKind() byte { return snapshotRecordStorage }
+
+// SnapshotWriter streams records into a snapshot, Close must be called to end it
+type SnapshotWriter struct {
+	w         *bufio.Writer
+	chunkSize int
+	chunk     bytes.Buffer
+	records   uint32
+	sums      hash.Hash
+}
+
+// NewSnapshotWriter writes the header of a snapshot of the state at height into w
+func NewSnapshotWriter(w io.Writer, height int64, chunkSize int) (*SnapshotWriter, error) {
+	if chunkSize <= 0 || chunkSize > maxSnapshotChunkSize {
+		return nil, fmt.Errorf("invalid snapshot chunk size: %d", chunkSize)
+	}
+
+	sw := &SnapshotWriter{
+		w:         bufio.NewWriter(w),
+		chunkSize: chunkSize,
+		sums:      sha256.New(),
+	}
+
+	var header [16]byte
+	copy(header[:8], snapshotMagic)
+	binary.BigEndian.PutUint64(header[8:], uint64(height))
+	if _, err := sw.w.Write(header[:]); err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// Write appends a record to the snapshot
+func (sw *SnapshotWriter) Write(record SnapshotRecord) error {
+	sw.chunk.WriteByte(record.snapshotRecordKind())
+	switch r := record.(type) {
+	case SnapshotAccount:
+		balance := r.Balance
+		if balance == nil {
+			balance = new(big.Int)
+		}
+		if balance.Sign() < 0 {
+			return fmt.Errorf("negative balance of account %s", r.Address.String())
+		}
+		sw.putBytes(r.Address)
+		sw.putUvarint(r.Nonce)
+		sw.putBytes(balance.Bytes())
+		sw.putBytes(r.CodeHash)
+	case SnapshotCode:
+		sw.putBytes(r.Hash)
+		sw.putBytes(r.Code)
+	case SnapshotStorage:
+		sw.putBytes(r.Address)
+		sw.putBytes(r.Key.Bytes())
+		sw.putBytes(bytes.TrimLeft(r.Value.Bytes(), "\x00"))
+		sw.putBytes(r.Slot)
+	default:
+		return fmt.Errorf("unknown snapshot record: %T", record)
+	}
+	sw.records++
+
+	if sw.chunk.Len() >= sw.chunkSize {
+		return sw.flush()
+	}
+	return nil
+}
+
+// Close writes the last chunk and the end of the snapshot, it does not close the
+// underlying writer
+func (sw *SnapshotWriter) Close() error {
+	if err := sw.flush(); err != nil {
+		return err
+	}
+
+	var end [8]byte
+	if _, err := sw.w.Write(end[:]); err != nil {
+		return err
+	}
+	if _, err := sw.w.Write(sw.sums.Sum(nil)); err != nil {
+		return err
+	}
+
+	return sw.w.Flush()
+}
+
+func (sw *SnapshotWriter) flush() error {
+	if sw.records == 0 {
+		return nil
+	}
+
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(sw.chunk.Len()))
+	binary.BigEndian.PutUint32(header[4:], sw.records)
+	sum := sha256.Sum256(sw.chunk.Bytes())
+
+	for _, b := range [][]byte{header[:], sw.chunk.Bytes(), sum[:]} {
+		if _, err := sw.w.Write(b); err != nil {
+			return err
+		}
+	}
+	sw.sums.Write(sum[:])
+
+	sw.chunk.Reset()
+	sw.records = 0
+	return nil
+}
+
+func (sw *SnapshotWriter) putUvarint(x uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	sw.chunk.Write(buf[:binary.PutUvarint(buf[:], x)])
+}
+
+func (sw *SnapshotWriter) putBytes(b []byte) {
+	sw.putUvarint(uint64(len(b)))
+	sw.chunk.Write(b)
+}
+
+// SnapshotReader reads the records of a snapshot, the checksum of a chunk is verified
+// before its records are returned
+type SnapshotReader struct {
+	r       *bufio.Reader
+	height  int64
+	chunk   *bytes.Reader
+	records uint32
+	sums    hash.Hash
+	done    bool
+}
+
+// NewSnapshotReader reads the header of the snapshot in r
+func NewSnapshotReader(r io.Reader) (*SnapshotReader, error) {
+	sr := &SnapshotReader{
+		r:    bufio.NewReader(r),
+		sums: sha256.New(),
+	}
+
+	var header [16]byte
+	if _, err := io.ReadFull(sr.r, header[:]); err != nil {
+		return nil, fmt.Errorf("invalid snapshot header: %v", err)
+	}
+	if !bytes.Equal(header[:8], snapshotMagic) {
+		return nil, errors.New("not a vm state snapshot")
+	}
+	sr.height = int64(binary.BigEndian.Uint64(header[8:]))
+
+	return sr, nil
+}
+
+// Height returns the height the snapshot was taken at
+func (sr *SnapshotReader) Height() int64 {
+	return sr.height
+}
+
+// Next returns the next record of the snapshot, io.EOF once the end of the snapshot is
+// read and verified
+func (sr *SnapshotReader) Next() (SnapshotRecord, error) {
+	for sr.records == 0 {
+		if sr.done {
+			return nil, io.EOF
+		}
+		if sr.chunk != nil && sr.chunk.Len() != 0 {
+			return nil, errors.New("snapshot chunk holds more data than its records")
+		}
+		if err := sr.readChunk(); err != nil {
+			return nil, err
+		}
+	}
+
+	kind, err := sr.chunk.ReadByte()
+	if err != nil {
+		return nil, errors.New("snapshot chunk holds less data than its records")
+	}
+	sr.records--
+
+	switch kind {
+	case snapshotRecordAccount:
+		var r SnapshotAccount
+		var balance []byte
+		if r.Address, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		if r.Nonce, err = binary.ReadUvarint(sr.chunk); err != nil {
+			return nil, err
+		}
+		if balance, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		r.Balance = new(big.Int).SetBytes(balance)
+		if r.CodeHash, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		return r, nil
+	case snapshotRecordCode:
+		var r SnapshotCode
+		if r.Hash, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		if r.Code, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		return r, nil
+	case snapshotRecordStorage:
+		var r SnapshotStorage
+		var key, value []byte
+		if r.Address, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		if key, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		if value, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		if len(key) != sdk.HashLength || len(value) > sdk.HashLength {
+			return nil, errors.New("invalid snapshot storage record")
+		}
+		r.Key = sdk.BytesToHash(key)
+		r.Value = sdk.BytesToHash(value)
+		if r.Slot, err = sr.getBytes(); err != nil {
+			return nil, err
+		}
+		return r, nil
+	default:
+		return nil, fmt.Errorf("unknown snapshot record kind: %d", kind)
+	}
+}
+
+func (sr *SnapshotReader) readChunk() error {
+	var header [8]byte
+	if _, err := io.ReadFull(sr.r, header[:]); err != nil {
+		return fmt.Errorf("truncated snapshot: %v", err)
+	}
+	size := binary.BigEndian.Uint32(header[:4])
+	records := binary.BigEndian.Uint32(header[4:])
+	if size > maxSnapshotChunkSize {
+		return fmt.Errorf("snapshot chunk too large: %d", size)
+	}
+
+	payload := make([]byte, size)
+	var sum [sha256.Size]byte
+	if _, err := io.ReadFull(sr.r, payload); err != nil {
+		return fmt.Errorf("truncated snapshot: %v", err)
+	}
+	if _, err := io.ReadFull(sr.r, sum[:]); err != nil {
+		return fmt.Errorf("truncated snapshot: %v", err)
+	}
+
+	// the end of the snapshot commits to the checksums of all the chunks
+	if size == 0 && records == 0 {
+		if !bytes.Equal(sum[:], sr.sums.Sum(nil)) {
+			return errors.New("snapshot checksum mismatch")
+		}
+		sr.done = true
+		return nil
+	}
+
+	if sha256.Sum256(payload) != sum {
+		return errors.New("snapshot chunk checksum mismatch")
+	}
+	sr.sums.Write(sum[:])
+	sr.chunk = bytes.NewReader(payload)
+	sr.records = records
+
+	return nil
+}
+
+func (sr *SnapshotReader) getBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(sr.chunk)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(sr.chunk.Len()) {
+		return nil, errors.New("snapshot record exceeds its chunk")
+	}
+
+	b := make([]byte, n)
+	_, err = io.ReadFull(sr.chunk, b)
+	return b, err
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"io"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/exported"
+	supplyexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
+	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// ExportSnapshot streams the codes, the accounts and the contract storage into w. The
+// module accounts are left out, their balances belong to the chain the snapshot is
+// taken from
+func (csdb *CommitStateDB) ExportSnapshot(w io.Writer, chunkSize int) error {
+	sw, err := NewSnapshotWriter(w, csdb.ctx.BlockHeight(), chunkSize)
+	if err != nil {
+		return err
+	}
+
+	codeStore := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixCode)
+	codeIter := codeStore.Iterator(nil, nil)
+	defer codeIter.Close()
+
+	for ; codeIter.Valid(); codeIter.Next() {
+		if err := sw.Write(SnapshotCode{Hash: codeIter.Key(), Code: codeIter.Value()}); err != nil {
+			return err
+		}
+	}
+
+	csdb.ak.IterateAccounts(csdb.ctx, func(acc exported.Account) bool {
+		if _, ok := acc.(supplyexported.ModuleAccountI); ok {
+			return false
+		}
+
+		err = sw.Write(SnapshotAccount{
+			Address:  acc.GetAddress(),
+			Nonce:    acc.GetSequence(),
+			Balance:  acc.GetCoins().AmountOf(sdk.NativeTokenName).BigInt(),
+			CodeHash: acc.GetCodeHash(),
+		})
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStorage)
+	slotStore := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStoragePreimage)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) != sdk.AddrLen+sdk.HashLength {
+			continue
+		}
+
+		err := sw.Write(SnapshotStorage{
+			Address: sdk.AccAddress(key[:sdk.AddrLen]),
+			Key:     sdk.BytesToHash(key[sdk.AddrLen:]),
+			Value:   sdk.BytesToHash(iter.Value()),
+			Slot:    slotStore.Get(key),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return sw.Close()
+}
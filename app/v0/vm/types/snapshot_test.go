@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func readSnapshot(t *testing.T, bz []byte) (int64, []SnapshotRecord, error) {
+	sr, err := NewSnapshotReader(bytes.NewReader(bz))
+	require.NoError(t, err)
+
+	var records []SnapshotRecord
+	for {
+		record, err := sr.Next()
+		if err == io.EOF {
+			return sr.Height(), records, nil
+		}
+		if err != nil {
+			return sr.Height(), records, err
+		}
+		records = append(records, record)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	addr := sdk.AccAddress([]byte("contract____________"))
+	records := []SnapshotRecord{
+		SnapshotCode{Hash: []byte("hash"), Code: []byte{0x60, 0x00}},
+		SnapshotAccount{Address: addr, Nonce: 7, Balance: big.NewInt(1000), CodeHash: []byte("hash")},
+		SnapshotAccount{Address: sdk.AccAddress([]byte("user________________")), Balance: new(big.Int)},
+		SnapshotStorage{Address: addr, Key: StorageKey(addr, []byte{1}), Value: sdk.BigToHash(big.NewInt(42)), Slot: []byte{1}},
+		SnapshotStorage{Address: addr, Key: sdk.BytesToHash([]byte("key")), Value: sdk.BigToHash(big.NewInt(1))},
+	}
+
+	// a chunk size of one byte puts every record in its own chunk
+	for _, chunkSize := range []int{1, DefaultSnapshotChunkSize} {
+		var buf bytes.Buffer
+		sw, err := NewSnapshotWriter(&buf, 100, chunkSize)
+		require.NoError(t, err)
+		for _, r := range records {
+			require.NoError(t, sw.Write(r))
+		}
+		require.NoError(t, sw.Close())
+
+		height, read, err := readSnapshot(t, buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int64(100), height)
+		require.Len(t, read, len(records))
+		for i := range records {
+			require.Equal(t, records[i].snapshotRecordKind(), read[i].snapshotRecordKind())
+		}
+		require.Equal(t, records[0], read[0])
+		require.Equal(t, uint64(7), read[1].(SnapshotAccount).Nonce)
+		require.Equal(t, int64(1000), read[1].(SnapshotAccount).Balance.Int64())
+		require.Equal(t, records[3], read[3])
+		require.Empty(t, read[4].(SnapshotStorage).Slot)
+
+		// a flipped byte of the payload fails the checksum of its chunk
+		corrupted := append([]byte{}, buf.Bytes()...)
+		corrupted[16+8+1] ^= 0xff
+		_, _, err = readSnapshot(t, corrupted)
+		require.Error(t, err)
+
+		// a dropped chunk fails the checksum of the end
+		if chunkSize == 1 {
+			first := 8 + int(binary.BigEndian.Uint32(buf.Bytes()[16:20])) + sha256.Size
+			dropped := append(append([]byte{}, buf.Bytes()[:16]...), buf.Bytes()[16+first:]...)
+			_, _, err = readSnapshot(t, dropped)
+			require.Error(t, err)
+		}
+
+		// a truncated snapshot has no end
+		_, _, err = readSnapshot(t, buf.Bytes()[:buf.Len()-1])
+		require.Error(t, err)
+	}
+
+	_, err := NewSnapshotReader(bytes.NewReader([]byte("not a snapshot...")))
+	require.Error(t, err)
+}
+
+func TestGethAllocRoundTrip(t *testing.T) {
+	contract := ethcmn.HexToAddress("0x0000000000000000000000000000000000000abc")
+	user := ethcmn.HexToAddress("0x0000000000000000000000000000000000000def")
+	alloc := core.GenesisAlloc{
+		contract: {
+			Code:    []byte{0x60, 0x2a, 0x60, 0x00, 0x55},
+			Nonce:   1,
+			Balance: big.NewInt(5),
+			Storage: map[ethcmn.Hash]ethcmn.Hash{
+				ethcmn.BigToHash(big.NewInt(0)): ethcmn.BigToHash(big.NewInt(42)),
+				ethcmn.BigToHash(big.NewInt(1)): {},
+			},
+		},
+		user: {Balance: big.NewInt(1000)},
+	}
+
+	var buf bytes.Buffer
+	sw, err := NewSnapshotWriter(&buf, 0, DefaultSnapshotChunkSize)
+	require.NoError(t, err)
+	require.NoError(t, GethAllocToSnapshot(alloc, sw))
+
+	sr, err := NewSnapshotReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	converted, skipped, err := SnapshotToGethAlloc(sr)
+	require.NoError(t, err)
+	require.Equal(t, 0, skipped)
+
+	// the zero value slot is not written
+	delete(alloc[contract].Storage, ethcmn.BigToHash(big.NewInt(1)))
+	require.Equal(t, alloc, converted)
+
+	parsed, err := ParseGethAlloc([]byte(`{"config":{},"alloc":{"0x0000000000000000000000000000000000000def":{"balance":"0x3e8"}}}`))
+	require.NoError(t, err)
+	require.Equal(t, core.GenesisAlloc{user: alloc[user]}, parsed)
+
+	parsed, err = ParseGethAlloc([]byte(`{"0x0000000000000000000000000000000000000def":{"balance":"1000"}}`))
+	require.NoError(t, err)
+	require.Equal(t, core.GenesisAlloc{user: alloc[user]}, parsed)
+}
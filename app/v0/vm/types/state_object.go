@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/tendermint/tendermint/crypto"
-
 	authexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/exported"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
+	supplyexported "github.com/Dipper-Labs/Dipper-Protocol/app/v0/supply/exported"
 	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 )
@@ -49,6 +48,10 @@ type (
 		stateDB *CommitStateDB
 		account *types.BaseAccount
 
+		// moduleAccount is the module account the object was loaded from, its name and
+		// permissions are kept when the account is written back
+		moduleAccount supplyexported.ModuleAccountI
+
 		// DB error.
 		// State objects are used by the consensus core and VM which are
 		// unable to deal with database-level errors. Any error that occurs
@@ -60,6 +63,7 @@ type (
 
 		originStorage sdk.Storage // Storage cache of original entries to dedup rewrites
 		dirtyStorage  sdk.Storage // Storage entries that need to be flushed to disk
+		slots         sdk.Storage // Slots written by the contract by the key they are stored under
 
 		// cache flags
 		//
@@ -72,7 +76,7 @@ type (
 )
 
 func newObject(db *CommitStateDB, accProto authexported.Account) *stateObject {
-	acc, ok := accProto.(*types.BaseAccount)
+	acc, moduleAcc, ok := baseAccount(accProto)
 	if !ok {
 		panic(fmt.Sprintf("invalid account type for state object: %T", accProto))
 	}
@@ -80,9 +84,11 @@ func newObject(db *CommitStateDB, accProto authexported.Account) *stateObject {
 	return &stateObject{
 		stateDB:       db,
 		account:       acc,
+		moduleAccount: moduleAcc,
 		address:       acc.Address,
 		originStorage: make(sdk.Storage),
 		dirtyStorage:  make(sdk.Storage),
+		slots:         make(sdk.Storage),
 	}
 }
 
@@ -108,6 +114,7 @@ func (so *stateObject) SetState(key, value sdk.Hash) {
 		prevValue: prev,
 	})
 
+	so.slots[prefixKey] = key
 	so.setState(prefixKey, value)
 }
 
@@ -212,10 +219,18 @@ func (so *stateObject) commitState() {
 	ctx := so.stateDB.ctx
 	store := prefix.NewStore(ctx.KVStore(so.stateDB.storageKey), AddressStoragePrefix(so.address))
 
+	// the slots are recorded for the snapshots from the storage rent version on, free of gas
+	// as they are not read by the vm
+	storageRent := so.stateDB.storageRent
+	slotStore := prefix.NewStore(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(so.stateDB.storageKey), StoragePreimagePrefix(so.address))
+
+	// the storage footprint the rent of the contract is charged for
+	var storageBytes int64
 	for key, value := range so.dirtyStorage {
 		delete(so.dirtyStorage, key)
 
-		if value == so.originStorage[key] {
+		prev := so.originStorage[key]
+		if value == prev {
 			continue
 		}
 
@@ -223,11 +238,23 @@ func (so *stateObject) commitState() {
 
 		if (value == sdk.Hash{}) {
 			store.Delete(key.Bytes())
+			if storageRent {
+				slotStore.Delete(key.Bytes())
+			}
+			storageBytes -= StorageSlotBytes
 			continue
 		}
 
+		if (prev == sdk.Hash{}) {
+			storageBytes += StorageSlotBytes
+			if slot, ok := so.slots[key]; ok && storageRent {
+				slotStore.Set(key.Bytes(), slot.Bytes())
+			}
+		}
 		store.Set(key.Bytes(), value.Bytes())
 	}
+
+	so.stateDB.addStorageBytes(so.address, storageBytes)
 }
 
 // commitCode persists the state object's code to the KVStore.
@@ -332,10 +359,12 @@ func (so *stateObject) ReturnGas(gas *big.Int) {}
 
 func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 	newStateObj := newObject(db, so.account)
+	newStateObj.moduleAccount = so.moduleAccount
 
 	newStateObj.code = so.code
 	newStateObj.dirtyStorage = so.dirtyStorage.Copy()
 	newStateObj.originStorage = so.originStorage.Copy()
+	newStateObj.slots = so.slots.Copy()
 	newStateObj.suicided = so.suicided
 	newStateObj.dirtyCode = so.dirtyCode
 	newStateObj.deleted = so.deleted
@@ -343,9 +372,11 @@ func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 	return newStateObj
 }
 
-// empty returns whether the account is considered empty.
+// empty returns whether the account is considered empty. Module accounts are never
+// removed, they keep no sequence to tell them from an unused account.
 func (so *stateObject) empty() bool {
-	return so.account.Sequence == 0 &&
+	return so.moduleAccount == nil &&
+		so.account.Sequence == 0 &&
 		so.account.Balance().Sign() == 0 &&
 		len(so.account.CodeHash) == 0
 }
@@ -365,13 +396,7 @@ func (so *stateObject) touch() {
 // GetStorageByAddressKey returns a hash of the composite key for a state
 // object's storage prefixed with it's address.
 func (so stateObject) GetStorageByAddressKey(key []byte) sdk.Hash {
-	prefix := so.Address().Bytes()
-	compositeKey := make([]byte, len(prefix)+len(key))
-
-	copy(compositeKey, prefix)
-	copy(compositeKey[len(prefix):], key)
-
-	return sdk.BytesToHash(crypto.Sha256(compositeKey))
+	return StorageKey(so.Address(), key)
 }
 
 type SO struct {
@@ -386,3 +411,35 @@ type SO struct {
 }
 
 type SOs []SO
+
+// baseAccount returns the base account a state object is kept in for acc, module accounts
+// are copied into one and returned as the second value
+func baseAccount(acc authexported.Account) (*types.BaseAccount, supplyexported.ModuleAccountI, bool) {
+	switch acc := acc.(type) {
+	case *types.BaseAccount:
+		return acc, nil, true
+	case supplyexported.ModuleAccountI:
+		return &types.BaseAccount{
+			Address:       acc.GetAddress(),
+			Coins:         acc.GetCoins(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			CodeHash:      acc.GetCodeHash(),
+		}, acc, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// storedAccount returns the account written into the account store for the state object
+func (so *stateObject) storedAccount() authexported.Account {
+	if so.moduleAccount == nil {
+		return so.account
+	}
+
+	// module accounts keep no sequence, the nonce of the state object is not stored
+	if err := so.moduleAccount.SetCoins(so.account.Coins); err != nil {
+		panic(err)
+	}
+	return so.moduleAccount
+}
@@ -7,10 +7,10 @@ import (
 	"sort"
 	"sync"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth"
-	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common/math"
 	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
 	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
@@ -36,6 +36,11 @@ type CommitStateDB struct {
 
 	storageKey sdk.StoreKey
 
+	// ethCompatible hashes code with keccak256 instead of sha256, as Ethereum does
+	ethCompatible bool
+	// storageRent records the storage footprint of the contracts and the slots they write
+	storageRent bool
+
 	// maps that hold 'live' objects, which will get modified while processing a
 	// state transition
 	stateObjects      map[string]*stateObject
@@ -48,6 +53,10 @@ type CommitStateDB struct {
 	txIndex      int
 	logs         map[sdk.Hash][]*Log
 
+	// receipts of failed txs, the writes of a failed tx are discarded so they
+	// are persisted on Commit
+	failedReceipts []*Receipt
+
 	// TODO: Determine if we actually need this as we do not need preimages in
 	// the SDK, but it seems to be used elsewhere in Geth.
 	preimages map[sdk.Hash][]byte
@@ -59,6 +68,16 @@ type CommitStateDB struct {
 	// by StateDB.Commit.
 	dbErr error
 
+	// cache branches of the context written by the native calls of the
+	// precompiled contracts, flushed on Finalise and Commit
+	nativeCalls []nativeCall
+
+	// accounts and storage slots accessed by the current tx, see EIP-2929
+	accessList *accessList
+
+	// storage of the contracts dropped at the end of the tx, see EIP-1153
+	transientStorage transientStorage
+
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
 	journal        *journal
@@ -83,6 +102,8 @@ func NewCommitStateDB(ak auth.AccountKeeper, storageKey sdk.StoreKey) *CommitSta
 		stateObjectsDirty: make(map[string]struct{}),
 		logs:              make(map[sdk.Hash][]*Log),
 		preimages:         make(map[sdk.Hash][]byte),
+		accessList:        newAccessList(),
+		transientStorage:  newTransientStorage(),
 		journal:           newJournal(),
 	}
 }
@@ -91,10 +112,14 @@ func NewStateDB(db *CommitStateDB) *CommitStateDB {
 	return &CommitStateDB{
 		ak:                db.ak,
 		storageKey:        db.storageKey,
+		ethCompatible:     db.ethCompatible,
+		storageRent:       db.storageRent,
 		stateObjects:      make(map[string]*stateObject),
 		stateObjectsDirty: make(map[string]struct{}),
 		logs:              make(map[sdk.Hash][]*Log),
 		preimages:         make(map[sdk.Hash][]byte),
+		accessList:        newAccessList(),
+		transientStorage:  newTransientStorage(),
 		journal:           newJournal(),
 	}
 }
@@ -102,9 +127,42 @@ func NewStateDB(db *CommitStateDB) *CommitStateDB {
 // WithContext returns a Database with an updated sdk context
 func (csdb *CommitStateDB) WithContext(ctx sdk.Context) *CommitStateDB {
 	csdb.ctx = ctx
+	csdb.nativeCalls = nil
 	return csdb
 }
 
+// SetEthCompatible switches code hashing and contract address derivation to
+// the rules of Ethereum
+func (csdb *CommitStateDB) SetEthCompatible(ethCompatible bool) {
+	csdb.ethCompatible = ethCompatible
+}
+
+// EthCompatible returns whether code hashing and contract address derivation
+// follow the rules of Ethereum
+func (csdb *CommitStateDB) EthCompatible() bool {
+	return csdb.ethCompatible
+}
+
+// SetStorageRent records the storage footprint of the contracts their rent is charged for
+// and the slots they write
+func (csdb *CommitStateDB) SetStorageRent(storageRent bool) {
+	csdb.storageRent = storageRent
+}
+
+// StorageRent returns whether the storage footprint of the contracts is recorded and their
+// rent charged
+func (csdb *CommitStateDB) StorageRent() bool {
+	return csdb.storageRent
+}
+
+// HashCode returns the hash the code is stored under
+func (csdb *CommitStateDB) HashCode(code []byte) sdk.Hash {
+	if csdb.ethCompatible {
+		return sdk.BytesToHash(ethcrypto.Keccak256(code))
+	}
+	return sdk.BytesToHash(crypto.Sha256(code))
+}
+
 // ContractCreatedEvent emit event of contract created
 // nolint
 func (csdb *CommitStateDB) ContractCreatedEvent(addr sdk.AccAddress) {
@@ -132,6 +190,11 @@ func (csdb *CommitStateDB) WithTxHash(txHash []byte) *CommitStateDB {
 	return csdb
 }
 
+// TxHash returns the hash of the current transaction
+func (csdb *CommitStateDB) TxHash() sdk.Hash {
+	return csdb.thash
+}
+
 // ----------------------------------------------------------------------------
 // Setters
 // ----------------------------------------------------------------------------
@@ -180,7 +243,7 @@ func (csdb *CommitStateDB) SetState(addr sdk.AccAddress, key, value sdk.Hash) {
 func (csdb *CommitStateDB) SetCode(addr sdk.AccAddress, code []byte) {
 	so := csdb.GetOrNewStateObject(addr)
 	if so != nil {
-		so.SetCode(sdk.BytesToHash(crypto.Sha256(code)), code)
+		so.SetCode(csdb.HashCode(code), code)
 	}
 }
 
@@ -195,6 +258,23 @@ func (csdb *CommitStateDB) AddLog(log *Log) {
 	csdb.logs[csdb.thash] = append(csdb.logs[csdb.thash], log)
 }
 
+// SetReceipt writes the receipt of a transaction to the store.
+func (csdb *CommitStateDB) SetReceipt(receipt *Receipt) {
+	d, err := json.Marshal(receipt)
+	if err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixReceipts)
+	store.Set(receipt.TxHash.Bytes(), d)
+}
+
+// AddFailedReceipt keeps the receipt of a failed transaction until Commit.
+func (csdb *CommitStateDB) AddFailedReceipt(receipt *Receipt) {
+	csdb.failedReceipts = append(csdb.failedReceipts, receipt)
+}
+
 // AddPreimage records a SHA3 preimage seen by the VM.
 func (csdb *CommitStateDB) AddPreimage(hash sdk.Hash, preimage []byte) {
 	if _, ok := csdb.preimages[hash]; !ok {
@@ -329,6 +409,10 @@ func (csdb *CommitStateDB) GetLogs(hash sdk.Hash) (logs []*Log) {
 	ctx := csdb.ctx
 	store := prefix.NewStore(ctx.KVStore(csdb.storageKey), KeyPrefixLogs)
 	d := store.Get(hash.Bytes())
+	if d == nil {
+		return
+	}
+
 	err := json.Unmarshal(d, &logs)
 	if err != nil {
 		ctx.Logger().Error(err.Error())
@@ -338,6 +422,53 @@ func (csdb *CommitStateDB) GetLogs(hash sdk.Hash) (logs []*Log) {
 	return
 }
 
+// GetReceipt returns the receipt of a transaction, nil if there is none.
+func (csdb *CommitStateDB) GetReceipt(hash sdk.Hash) *Receipt {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixReceipts)
+	d := store.Get(hash.Bytes())
+	if d == nil {
+		return nil
+	}
+
+	var receipt Receipt
+	if err := json.Unmarshal(d, &receipt); err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return nil
+	}
+
+	return &receipt
+}
+
+// SetContractMeta writes the deployer, ABI and metadata of a contract to the store.
+func (csdb *CommitStateDB) SetContractMeta(addr sdk.AccAddress, meta ContractMeta) {
+	d, err := json.Marshal(meta)
+	if err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractMeta)
+	store.Set(addr.Bytes(), d)
+}
+
+// GetContractMeta returns the deployer, ABI and metadata of a contract, or nil if
+// nothing was recorded for it.
+func (csdb *CommitStateDB) GetContractMeta(addr sdk.AccAddress) *ContractMeta {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractMeta)
+	d := store.Get(addr.Bytes())
+	if d == nil {
+		return nil
+	}
+
+	var meta ContractMeta
+	if err := json.Unmarshal(d, &meta); err != nil {
+		csdb.ctx.Logger().Error(err.Error())
+		return nil
+	}
+
+	return &meta
+}
+
 // Logs returns all the current logs in the state.
 func (csdb *CommitStateDB) Logs() []*Log { // todo: is should get all logs from store?
 	logs := make([]*Log, 0, len(csdb.logs))
@@ -411,6 +542,13 @@ func (csdb *CommitStateDB) Commit(deleteEmptyObjects bool) (root sdk.Hash, err e
 		delete(csdb.stateObjectsDirty, addr)
 	}
 
+	csdb.flushNativeCalls()
+
+	for _, receipt := range csdb.failedReceipts {
+		csdb.SetReceipt(receipt)
+	}
+	csdb.failedReceipts = nil
+
 	// NOTE: Ethereum returns the trie merkle root here, but as commitment
 	// actually happens in the BaseApp at EndBlocker, we do not know the root at
 	// this time.
@@ -437,6 +575,7 @@ func (csdb *CommitStateDB) commitLogs() {
 
 		ctx.Logger().Debug(fmt.Sprintf("set logs, txHash: %s, logs: %s", hash.String(), string(d)))
 		store.Set(hash.Bytes(), d)
+		csdb.indexLogs(hash, csdb.logs[hash])
 	}
 }
 
@@ -500,9 +639,14 @@ func (csdb *CommitStateDB) Finalise(deleteEmptyObjects bool) {
 		csdb.stateObjectsDirty[addr] = struct{}{}
 	}
 
+	csdb.flushNativeCalls()
+
 	csdb.commitLogs()
 	csdb.ClearLogs()
 
+	// the transient storage lives for the tx
+	csdb.transientStorage = newTransientStorage()
+
 	// invalidate journal because reverting across transactions is not allowed
 	csdb.clearJournalAndRefund()
 }
@@ -522,7 +666,7 @@ func (csdb *CommitStateDB) IntermediateRoot(deleteEmptyObjects bool) sdk.Hash {
 
 // updateStateObject writes the given state object to the store.
 func (csdb *CommitStateDB) updateStateObject(so *stateObject) {
-	csdb.ak.SetAccount(csdb.ctx, so.account)
+	csdb.ak.SetAccount(csdb.ctx, so.storedAccount())
 }
 
 // deleteStateObject removes the given state object from the state store.
@@ -630,6 +774,8 @@ func (csdb *CommitStateDB) Reset(_ sdk.Hash) error {
 	csdb.txIndex = 0
 	csdb.logs = make(map[sdk.Hash][]*Log)
 	csdb.preimages = make(map[sdk.Hash][]byte)
+	csdb.accessList = newAccessList()
+	csdb.transientStorage = newTransientStorage()
 
 	csdb.clearJournalAndRefund()
 	return nil
@@ -643,11 +789,12 @@ func (csdb *CommitStateDB) UpdateAccounts() {
 			continue
 		}
 		accI := csdb.ak.GetAccount(csdb.ctx, addr)
-		acc, ok := accI.(*types.BaseAccount)
+		acc, moduleAcc, ok := baseAccount(accI)
 		if ok {
 			if (so.Balance() != acc.GetCoins().AmountOf(sdk.NativeTokenName).BigInt()) || (so.Nonce() != acc.GetSequence()) {
 				// If queried account's balance or nonce are invalid, update the account pointer
 				so.account = acc
+				so.moduleAccount = moduleAcc
 			}
 		}
 
@@ -703,11 +850,15 @@ func (csdb *CommitStateDB) Copy() *CommitStateDB {
 		ctx:               csdb.ctx,
 		ak:                csdb.ak,
 		storageKey:        csdb.storageKey,
+		ethCompatible:     csdb.ethCompatible,
+		storageRent:       csdb.storageRent,
 		stateObjects:      make(map[string]*stateObject, len(csdb.journal.dirties)),
 		stateObjectsDirty: make(map[string]struct{}, len(csdb.journal.dirties)),
 		refund:            csdb.refund,
 		logs:              make(map[sdk.Hash][]*Log, len(csdb.logs)),
 		preimages:         make(map[sdk.Hash][]byte),
+		accessList:        csdb.accessList.copy(),
+		transientStorage:  csdb.transientStorage.copy(),
 		journal:           newJournal(),
 	}
 
@@ -880,6 +1031,9 @@ func (csdb *CommitStateDB) ExportState() (s GenesisState) {
 	s.Storage = csdb.exportStorage()
 	s.Codes = csdb.exportCodes()
 	s.VMLogs = csdb.exportLogs()
+	s.ContractMetas = csdb.exportContractMetas()
+	s.CodeHistories = csdb.exportCodeHistories()
+	s.ContractRents = csdb.exportContractRents()
 	return
 }
 
@@ -899,6 +1053,21 @@ func (csdb *CommitStateDB) ImportState(s GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = csdb.importContractMetas(s.ContractMetas)
+	if err != nil {
+		panic(err)
+	}
+
+	err = csdb.importCodeHistories(s.CodeHistories)
+	if err != nil {
+		panic(err)
+	}
+
+	err = csdb.importContractRents(s.ContractRents)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (csdb *CommitStateDB) exportCodes() map[string]sdk.Code {
@@ -928,8 +1097,42 @@ func (csdb *CommitStateDB) importCodes(codes map[string]sdk.Code) error {
 	return nil
 }
 
+func (csdb *CommitStateDB) exportContractMetas() map[string]ContractMeta {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractMeta)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var metas map[string]ContractMeta
+	for ; iter.Valid(); iter.Next() {
+		var meta ContractMeta
+		if err := json.Unmarshal(iter.Value(), &meta); err != nil {
+			panic(err)
+		}
+
+		if metas == nil {
+			metas = make(map[string]ContractMeta)
+		}
+		metas[sdk.AccAddress(iter.Key()).String()] = meta
+	}
+
+	return metas
+}
+
+func (csdb *CommitStateDB) importContractMetas(metas map[string]ContractMeta) error {
+	for k, meta := range metas {
+		addr, err := sdk.AccAddressFromBech32(k)
+		if err != nil {
+			return err
+		}
+		csdb.SetContractMeta(addr, meta)
+	}
+
+	return nil
+}
+
 func (csdb *CommitStateDB) exportStorage() (gs []Storage) {
 	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStorage)
+	slotStore := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStoragePreimage)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -937,6 +1140,7 @@ func (csdb *CommitStateDB) exportStorage() (gs []Storage) {
 		gs = append(gs, Storage{
 			Key:   iter.Key(),
 			Value: iter.Value(),
+			Slot:  slotStore.Get(iter.Key()),
 		})
 	}
 
@@ -945,9 +1149,13 @@ func (csdb *CommitStateDB) exportStorage() (gs []Storage) {
 
 func (csdb *CommitStateDB) importStorage(gs []Storage) error {
 	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStorage)
+	slotStore := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixStoragePreimage)
 
 	for _, item := range gs {
 		store.Set(item.Key, item.Value)
+		if len(item.Slot) > 0 {
+			slotStore.Set(item.Key, item.Slot)
+		}
 	}
 
 	return nil
@@ -987,6 +1195,7 @@ func (csdb *CommitStateDB) importLogs(vmLogs VMLogs) error {
 		}
 
 		store.Set(txHash, []byte(logs))
+		csdb.reindexLogs(sdk.BytesToHash(txHash), []byte(logs))
 	}
 
 	logIndexStore := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixLogsIndex)
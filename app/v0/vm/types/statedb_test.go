@@ -1,8 +1,11 @@
 package types
 
 import (
+	"errors"
+	"math/big"
 	"testing"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -118,3 +121,174 @@ func TestCommitStateDB_ImportExport_Code(t *testing.T) {
 	// after SetCode, the state will changed
 	require.True(t, gsImport.EqualWithoutParams(gsExport))
 }
+
+func TestCommitStateDB_MigrateCodeHashes(t *testing.T) {
+	var (
+		addr1 = sdk.AccAddress{0x01}
+		addr2 = sdk.AccAddress{0x02}
+		addr3 = sdk.AccAddress{0x03}
+		code  = []byte{0x60, 0x00}
+		code2 = []byte{0x60, 0x01}
+	)
+
+	commitStateDB := buildCommitStateDB()
+
+	// two contracts share their code
+	commitStateDB.SetCode(addr1, code)
+	commitStateDB.SetCode(addr2, code)
+	commitStateDB.SetCode(addr3, code2)
+	_, err := commitStateDB.Commit(true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.BytesToHash(crypto.Sha256(code)), commitStateDB.GetCodeHash(addr1))
+
+	commitStateDB.SetEthCompatible(true)
+	require.Equal(t, sdk.BytesToHash(ethcrypto.Keccak256(code)), commitStateDB.HashCode(code))
+
+	n, err := commitStateDB.MigrateCodeHashes()
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	for i, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		c := [][]byte{code, code, code2}[i]
+		require.Equal(t, sdk.BytesToHash(ethcrypto.Keccak256(c)), commitStateDB.GetCodeHash(addr))
+		require.Equal(t, c, commitStateDB.GetCode(addr))
+	}
+
+	// only the code under the new hashes is left
+	codes := commitStateDB.exportCodes()
+	require.Len(t, codes, 2)
+	require.Contains(t, codes, sdk.BytesToHash(ethcrypto.Keccak256(code)).String())
+	require.Contains(t, codes, sdk.BytesToHash(ethcrypto.Keccak256(code2)).String())
+}
+
+func TestCommitStateDB_MigrateStorageBytes(t *testing.T) {
+	addr := sdk.AccAddress{0x04}
+	commitStateDB := buildCommitStateDB()
+
+	commitStateDB.SetCode(addr, []byte{0x60, 0x00})
+	commitStateDB.SetNonce(addr, 1)
+	commitStateDB.SetState(addr, sdk.BigToHash(big.NewInt(1)), sdk.BigToHash(big.NewInt(1)))
+	commitStateDB.SetState(addr, sdk.BigToHash(big.NewInt(2)), sdk.BigToHash(big.NewInt(2)))
+	commitStateDB.Finalise(true)
+	_, err := commitStateDB.Commit(true)
+	require.NoError(t, err)
+
+	// the storage written before the storage rent version is not counted
+	require.Equal(t, NewContractRent(0, sdk.ZeroInt(), false, 0), commitStateDB.GetContractRent(addr))
+	commitStateDB.SetContractRent(addr, NewContractRent(0, sdk.NewInt(10), false, 0))
+
+	require.Equal(t, 1, commitStateDB.MigrateStorageBytes())
+	require.Equal(t, NewContractRent(2*StorageSlotBytes, sdk.NewInt(10), false, StorageRentGracePeriod), commitStateDB.GetContractRent(addr))
+
+	// the slots written from then on are
+	commitStateDB.SetStorageRent(true)
+	commitStateDB.SetState(addr, sdk.BigToHash(big.NewInt(3)), sdk.BigToHash(big.NewInt(3)))
+	commitStateDB.Finalise(true)
+	require.Equal(t, uint64(3*StorageSlotBytes), commitStateDB.GetContractRent(addr).StorageBytes)
+}
+
+func TestCommitStateDB_NativeCall(t *testing.T) {
+	csdb := buildCommitStateDB()
+	base := csdb.Context()
+	a := sdk.AccAddress(crypto.AddressHash([]byte("a")))
+	b := sdk.AccAddress(crypto.AddressHash([]byte("b")))
+
+	// moves 40 from a, as left by the vm, to b
+	send := func(ctx sdk.Context) error {
+		acc := csdb.ak.GetAccount(ctx, a)
+		require.Equal(t, int64(100), acc.GetCoins().AmountOf(sdk.NativeTokenName).Int64())
+		require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 60))))
+		csdb.ak.SetAccount(ctx, acc)
+
+		to := csdb.ak.NewAccountWithAddress(ctx, b)
+		require.NoError(t, to.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeTokenName, 40))))
+		csdb.ak.SetAccount(ctx, to)
+		return nil
+	}
+
+	csdb.AddBalance(a, big.NewInt(100))
+
+	// a failed call keeps nothing
+	require.Error(t, csdb.NativeCall(func(ctx sdk.Context) error {
+		require.NoError(t, send(ctx))
+		return errors.New("failed")
+	}))
+	require.Equal(t, int64(100), csdb.GetBalance(a).Int64())
+	require.Nil(t, csdb.ak.GetAccount(csdb.Context(), b))
+
+	// reverting drops the branch of the call
+	snapshot := csdb.Snapshot()
+	require.NoError(t, csdb.NativeCall(send))
+	require.Equal(t, int64(60), csdb.GetBalance(a).Int64())
+	require.Equal(t, int64(40), csdb.GetCoinBalance(b, sdk.NativeTokenName).Int64())
+	csdb.RevertToSnapshot(snapshot)
+	require.Equal(t, int64(100), csdb.GetBalance(a).Int64())
+	require.Nil(t, csdb.ak.GetAccount(csdb.Context(), b))
+
+	// finalising writes the branch into the base context
+	require.NoError(t, csdb.NativeCall(send))
+	require.Nil(t, csdb.ak.GetAccount(base, b))
+	csdb.Finalise(true)
+	require.Equal(t, base, csdb.Context())
+	require.Equal(t, int64(40), csdb.ak.GetAccount(base, b).GetCoins().AmountOf(sdk.NativeTokenName).Int64())
+}
+
+func TestCommitStateDB_AccessList(t *testing.T) {
+	var (
+		sender   = sdk.AccAddress([]byte("sender______________"))
+		contract = sdk.AccAddress([]byte("contract____________"))
+		other    = sdk.AccAddress([]byte("other_______________"))
+		slot1    = sdk.BigToHash(big.NewInt(1))
+		slot2    = sdk.BigToHash(big.NewInt(2))
+	)
+
+	csdb := buildCommitStateDB()
+	csdb.PrepareAccessList(sender, contract, nil, AccessList{{Address: other, StorageKeys: []sdk.Hash{slot1}}})
+	require.True(t, csdb.AddressInAccessList(sender))
+	require.True(t, csdb.AddressInAccessList(contract))
+	addrOk, slotOk := csdb.SlotInAccessList(other, slot1)
+	require.True(t, addrOk && slotOk)
+
+	// the entries added after a snapshot are dropped by reverting it
+	snapshot := csdb.Snapshot()
+	csdb.AddSlotToAccessList(contract, slot2)
+	csdb.AddSlotToAccessList(other, slot2)
+	addrOk, slotOk = csdb.SlotInAccessList(contract, slot2)
+	require.True(t, addrOk && slotOk)
+
+	csdb.RevertToSnapshot(snapshot)
+	addrOk, slotOk = csdb.SlotInAccessList(contract, slot2)
+	require.True(t, addrOk)
+	require.False(t, slotOk)
+	_, slotOk = csdb.SlotInAccessList(other, slot2)
+	require.False(t, slotOk)
+	_, slotOk = csdb.SlotInAccessList(other, slot1)
+	require.True(t, slotOk)
+
+	// a new tx starts with a fresh list
+	csdb.PrepareAccessList(other, nil, nil, nil)
+	require.False(t, csdb.AddressInAccessList(sender))
+	require.True(t, csdb.AddressInAccessList(other))
+}
+
+func TestCommitStateDB_TransientStorage(t *testing.T) {
+	var (
+		contract = sdk.AccAddress([]byte("contract____________"))
+		key      = sdk.BigToHash(big.NewInt(1))
+	)
+
+	csdb := buildCommitStateDB()
+	csdb.SetTransientState(contract, key, sdk.BigToHash(big.NewInt(1)))
+
+	// the writes after a snapshot are dropped by reverting it
+	snapshot := csdb.Snapshot()
+	csdb.SetTransientState(contract, key, sdk.BigToHash(big.NewInt(2)))
+	require.Equal(t, sdk.BigToHash(big.NewInt(2)), csdb.GetTransientState(contract, key))
+	csdb.RevertToSnapshot(snapshot)
+	require.Equal(t, sdk.BigToHash(big.NewInt(1)), csdb.GetTransientState(contract, key))
+
+	// the transient storage is not written to the store and is dropped at the end of the tx
+	csdb.Finalise(true)
+	require.Equal(t, sdk.Hash{}, csdb.GetTransientState(contract, key))
+	require.Equal(t, sdk.Hash{}, csdb.GetState(contract, key))
+}
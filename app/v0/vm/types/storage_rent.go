@@ -0,0 +1,163 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/store/prefix"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// StorageSlotBytes is the footprint of a storage slot, its key and its value
+const StorageSlotBytes = 2 * sdk.HashLength
+
+// StorageRentGracePeriod is the number of blocks a contract holds storage free of rent once
+// its footprint is first recorded, its deposit is to be made in the meantime
+const StorageRentGracePeriod int64 = 100000
+
+// ContractRent is the storage footprint of a contract and the deposit its storage rent is
+// paid from. The rent is owed from the charged height on. A frozen contract rejects the
+// calls until its deposit is topped up
+type ContractRent struct {
+	StorageBytes  uint64  `json:"storage_bytes" yaml:"storage_bytes"`
+	Deposit       sdk.Int `json:"deposit" yaml:"deposit"`
+	Frozen        bool    `json:"frozen" yaml:"frozen"`
+	ChargedHeight int64   `json:"charged_height" yaml:"charged_height"`
+}
+
+// NewContractRent returns a ContractRent
+func NewContractRent(storageBytes uint64, deposit sdk.Int, frozen bool, chargedHeight int64) ContractRent {
+	return ContractRent{
+		StorageBytes:  storageBytes,
+		Deposit:       deposit,
+		Frozen:        frozen,
+		ChargedHeight: chargedHeight,
+	}
+}
+
+// Due returns the rent of a block at rate per storage byte
+func (r ContractRent) Due(rate uint64) sdk.Int {
+	due := new(big.Int).SetUint64(r.StorageBytes)
+	return sdk.NewIntFromBigInt(due.Mul(due, new(big.Int).SetUint64(rate)))
+}
+
+// Owed returns the rent owed at height for the blocks since the charged height, a frozen
+// contract owes none
+func (r ContractRent) Owed(rate uint64, height int64) sdk.Int {
+	if r.Frozen || height <= r.ChargedHeight {
+		return sdk.ZeroInt()
+	}
+	return r.Due(rate).MulRaw(height - r.ChargedHeight)
+}
+
+// IsFrozenAt returns whether the contract rejects the calls at height, it is frozen or its
+// deposit does not cover the rent it owes
+func (r ContractRent) IsFrozenAt(rate uint64, height int64) bool {
+	return r.Frozen || r.Deposit.LT(r.Owed(rate, height))
+}
+
+func (r ContractRent) String() string {
+	return fmt.Sprintf(`StorageBytes:  %d
+Deposit:       %s
+Frozen:        %t
+ChargedHeight: %d`, r.StorageBytes, r.Deposit.String(), r.Frozen, r.ChargedHeight)
+}
+
+// GetContractRent returns the storage footprint and rent deposit of a contract
+func (csdb *CommitStateDB) GetContractRent(addr sdk.AccAddress) ContractRent {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractRent)
+	d := store.Get(addr.Bytes())
+	if d == nil {
+		return NewContractRent(0, sdk.ZeroInt(), false, 0)
+	}
+
+	var rent ContractRent
+	if err := json.Unmarshal(d, &rent); err != nil {
+		panic(err)
+	}
+
+	return rent
+}
+
+// SetContractRent stores the storage footprint and rent deposit of a contract
+func (csdb *CommitStateDB) SetContractRent(addr sdk.AccAddress, rent ContractRent) {
+	d, err := json.Marshal(rent)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractRent)
+	store.Set(addr.Bytes(), d)
+}
+
+// IterateContractRents iterates over the contracts with a storage footprint or a deposit,
+// it stops when cb returns true
+func (csdb *CommitStateDB) IterateContractRents(cb func(addr sdk.AccAddress, rent ContractRent) (stop bool)) {
+	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storageKey), KeyPrefixContractRent)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rent ContractRent
+		if err := json.Unmarshal(iter.Value(), &rent); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.AccAddress(iter.Key()), rent) {
+			break
+		}
+	}
+}
+
+// addStorageBytes updates the storage footprint of a contract by the slots written to or
+// deleted from its storage. Nothing is written before the storage rent version, its
+// migration counts the slots of every contract. The rent of a contract starting to hold
+// storage is owed from then on, after the grace period for its first storage
+func (csdb *CommitStateDB) addStorageBytes(addr sdk.AccAddress, delta int64) {
+	if delta == 0 || !csdb.storageRent {
+		return
+	}
+
+	rent := csdb.GetContractRent(addr)
+	if rent.StorageBytes == 0 {
+		height := csdb.ctx.BlockHeight()
+		if rent.ChargedHeight == 0 {
+			height += StorageRentGracePeriod
+		}
+		if height > rent.ChargedHeight {
+			rent.ChargedHeight = height
+		}
+	}
+	if delta < 0 && uint64(-delta) > rent.StorageBytes {
+		rent.StorageBytes = 0
+	} else {
+		rent.StorageBytes = uint64(int64(rent.StorageBytes) + delta)
+	}
+	csdb.SetContractRent(addr, rent)
+}
+
+func (csdb *CommitStateDB) exportContractRents() map[string]ContractRent {
+	var rents map[string]ContractRent
+	csdb.IterateContractRents(func(addr sdk.AccAddress, rent ContractRent) bool {
+		if rents == nil {
+			rents = make(map[string]ContractRent)
+		}
+		rents[addr.String()] = rent
+		return false
+	})
+
+	return rents
+}
+
+func (csdb *CommitStateDB) importContractRents(rents map[string]ContractRent) error {
+	for k, rent := range rents {
+		addr, err := sdk.AccAddressFromBech32(k)
+		if err != nil {
+			return err
+		}
+		csdb.SetContractRent(addr, rent)
+	}
+
+	return nil
+}
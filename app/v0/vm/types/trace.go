@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/hexutil"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// CallTracerName selects the call tracer instead of the struct logger
+const CallTracerName = "callTracer"
+
+// TraceConfig holds the options of a transaction trace
+type TraceConfig struct {
+	Tracer         string `json:"tracer,omitempty" yaml:"tracer"`
+	DisableMemory  bool   `json:"disableMemory,omitempty" yaml:"disableMemory"`
+	DisableStack   bool   `json:"disableStack,omitempty" yaml:"disableStack"`
+	DisableStorage bool   `json:"disableStorage,omitempty" yaml:"disableStorage"`
+	Limit          int    `json:"limit,omitempty" yaml:"limit"`
+}
+
+// TraceTx is a tx of the traced block reduced to what the vm needs to replay it
+type TraceTx struct {
+	TxHash   sdk.Hash         `json:"tx_hash" yaml:"tx_hash"`
+	Signers  []sdk.AccAddress `json:"signers" yaml:"signers"`
+	Msgs     []MsgContract    `json:"msgs" yaml:"msgs"`
+	GasPrice sdk.Int          `json:"gas_price" yaml:"gas_price"`
+}
+
+// QueryTraceParams - for trace a tx, the query must be run at the height before
+// the block of the tx
+type QueryTraceParams struct {
+	Header abci.Header `json:"header" yaml:"header"`
+	Txs    []TraceTx   `json:"txs" yaml:"txs"` // txs of the block before the traced one
+	Tx     TraceTx     `json:"tx" yaml:"tx"`
+	Config TraceConfig `json:"config" yaml:"config"`
+}
+
+// ExecutionResult is the result of a tx traced by the struct logger
+type ExecutionResult struct {
+	Gas         uint64         `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []StructLogRes `json:"structLogs"`
+}
+
+func (r ExecutionResult) String() string {
+	j, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", err)
+	}
+	return string(j)
+}
+
+// StructLogRes is a formatted struct log
+type StructLogRes struct {
+	Pc      uint64             `json:"pc"`
+	Op      string             `json:"op"`
+	Gas     uint64             `json:"gas"`
+	GasCost uint64             `json:"gasCost"`
+	Depth   int                `json:"depth"`
+	Error   string             `json:"error,omitempty"`
+	Stack   *[]string          `json:"stack,omitempty"`
+	Memory  *[]string          `json:"memory,omitempty"`
+	Storage *map[string]string `json:"storage,omitempty"`
+}
+
+// CallFrame is a call or create in the call tree built by the call tracer
+type CallFrame struct {
+	Type    string         `json:"type"`
+	From    sdk.AccAddress `json:"from"`
+	To      sdk.AccAddress `json:"to,omitempty"`
+	Value   string         `json:"value,omitempty"`
+	Gas     uint64         `json:"gas"`
+	GasUsed uint64         `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output,omitempty"`
+	Error   string         `json:"error,omitempty"`
+	Calls   []CallFrame    `json:"calls,omitempty"`
+}
+
+func (f CallFrame) String() string {
+	j, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", err)
+	}
+	return string(j)
+}
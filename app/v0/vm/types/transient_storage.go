@@ -0,0 +1,65 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// transientStorage is the storage of the contracts that lives for a tx, see EIP-1153
+type transientStorage map[ethcmn.Address]map[sdk.Hash]sdk.Hash
+
+func newTransientStorage() transientStorage {
+	return make(transientStorage)
+}
+
+func (t transientStorage) get(address ethcmn.Address, key sdk.Hash) sdk.Hash {
+	return t[address][key]
+}
+
+func (t transientStorage) set(address ethcmn.Address, key, value sdk.Hash) {
+	if value == (sdk.Hash{}) {
+		if slots, ok := t[address]; ok {
+			delete(slots, key)
+			if len(slots) == 0 {
+				delete(t, address)
+			}
+		}
+		return
+	}
+
+	if _, ok := t[address]; !ok {
+		t[address] = make(map[sdk.Hash]sdk.Hash)
+	}
+	t[address][key] = value
+}
+
+func (t transientStorage) copy() transientStorage {
+	cp := make(transientStorage, len(t))
+	for address, slots := range t {
+		cpSlots := make(map[sdk.Hash]sdk.Hash, len(slots))
+		for key, value := range slots {
+			cpSlots[key] = value
+		}
+		cp[address] = cpSlots
+	}
+	return cp
+}
+
+// GetTransientState returns the value of a transient storage slot of the account
+func (csdb *CommitStateDB) GetTransientState(addr sdk.AccAddress, key sdk.Hash) sdk.Hash {
+	return csdb.transientStorage.get(ethcmn.BytesToAddress(addr), key)
+}
+
+// SetTransientState sets the value of a transient storage slot of the account, it is
+// dropped at the end of the tx
+func (csdb *CommitStateDB) SetTransientState(addr sdk.AccAddress, key, value sdk.Hash) {
+	address := ethcmn.BytesToAddress(addr)
+	prev := csdb.transientStorage.get(address, key)
+	if prev == value {
+		return
+	}
+
+	csdb.journal.append(transientStorageChange{address: address, key: key, prevalue: prev})
+	csdb.transientStorage.set(address, key, value)
+}
@@ -155,7 +155,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.PostEndBlocker != nil {
-		app.PostEndBlocker(&res)
+		app.PostEndBlocker(app.deliverState.ctx, &res)
 	}
 
 	return res
@@ -218,7 +218,11 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 			data = append(data, result.Data...)
 		}
 
-		return sdkerrors.ResponseDeliverTx(err, data, gInfo.GasWanted, gInfo.GasUsed, log)
+		res = sdkerrors.ResponseDeliverTx(err, data, gInfo.GasWanted, gInfo.GasUsed, log)
+		if result != nil {
+			res.Events = result.Events.ToABCIEvents()
+		}
+		return res
 	}
 
 	return abci.ResponseDeliverTx{
@@ -436,7 +440,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	// []string{"proposal", "test"} as the path.
 	resBytes, queryErr := querier(ctx, path[2:], req)
 	if queryErr != nil {
-		space, code, log := sdkerrors.ABCIInfo(err, false)
+		space, code, log := sdkerrors.ABCIInfo(queryErr, false)
 		return abci.ResponseQuery{
 			Code:      code,
 			Codespace: space,
@@ -450,6 +450,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 			logJSON := codec.Cdc.MustMarshalJSON(idxLogs)
 
+			// keep the events the handler emitted to describe the failure
+			if msgResult != nil {
+				events = events.AppendEvents(msgResult.Events)
+			}
+
 			return &sdk.Result{
 				Data:   data,
 				Log:    strings.TrimSpace(string(logJSON)),
@@ -0,0 +1,351 @@
+package ethrpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	authtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/auth/types"
+	vmutils "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/client/utils"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/common"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/version"
+)
+
+const (
+	// MaxLogsBlockRange is the largest block range eth_getLogs scans in one request
+	MaxLogsBlockRange = 1000
+
+	// logsPageLimit is the page size eth_getLogs queries the logs with
+	logsPageLimit = 1000
+)
+
+// PublicAPI implements the eth, net, web3 and debug namespaces on top of the vm querier
+type PublicAPI struct {
+	cliCtx context.CLIContext
+}
+
+// NewPublicAPI creates a new PublicAPI instance
+func NewPublicAPI(cliCtx context.CLIContext) PublicAPI {
+	return PublicAPI{cliCtx: cliCtx}
+}
+
+// ClientVersion returns the client version, web3_clientVersion
+func (api PublicAPI) ClientVersion() string {
+	return fmt.Sprintf("%s/%s", version.Name, version.Version)
+}
+
+// Sha3 returns the keccak-256 hash of the input, web3_sha3
+func (api PublicAPI) Sha3(input hexutil.Bytes) hexutil.Bytes {
+	return ethcrypto.Keccak256(input)
+}
+
+// NetVersion returns the numeric chain id as a decimal string, net_version
+func (api PublicAPI) NetVersion() (string, error) {
+	chainID, err := api.chainID()
+	if err != nil {
+		return "", err
+	}
+	return chainID.String(), nil
+}
+
+// ChainID returns the numeric chain id, eth_chainId
+func (api PublicAPI) ChainID() (*hexutil.Big, error) {
+	chainID, err := api.chainID()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(chainID), nil
+}
+
+// BlockNumber returns the latest block height, eth_blockNumber
+func (api PublicAPI) BlockNumber() (hexutil.Uint64, error) {
+	node, err := api.cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	return hexutil.Uint64(status.SyncInfo.LatestBlockHeight), nil
+}
+
+// GetBalance returns the native token balance of an account, eth_getBalance
+func (api PublicAPI) GetBalance(address string, bn BlockNumber) (*hexutil.Big, error) {
+	addr, err := AccAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := authtypes.NewAccountRetriever(api.cliCtx.WithHeight(bn.Int64())).GetAccount(addr)
+	if err != nil {
+		if strings.Contains(err.Error(), "does not exist") {
+			return (*hexutil.Big)(new(big.Int)), nil
+		}
+		return nil, err
+	}
+
+	return (*hexutil.Big)(acc.GetCoins().AmountOf(sdk.NativeTokenName).BigInt()), nil
+}
+
+// GetCode returns the contract code stored at an address, eth_getCode
+func (api PublicAPI) GetCode(address string, bn BlockNumber) (hexutil.Bytes, error) {
+	addr, err := AccAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryCode, addr)
+	res, _, err := api.cliCtx.WithHeight(bn.Int64()).Query(route)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetStorageAt returns the value stored at a contract storage slot, eth_getStorageAt
+func (api PublicAPI) GetStorageAt(address string, key string, bn BlockNumber) (hexutil.Bytes, error) {
+	addr, err := AccAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	slot := sdk.BytesToHash(common.FromHex(key))
+	route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryStorage, addr, hex.EncodeToString(slot.Bytes()))
+	res, _, err := api.cliCtx.WithHeight(bn.Int64()).Query(route)
+	if err != nil {
+		return nil, err
+	}
+
+	var out types.QueryStorageResult
+	if err := api.cliCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Value.Bytes(), nil
+}
+
+// Call executes a message call without creating a transaction, eth_call
+func (api PublicAPI) Call(args CallArgs, bn BlockNumber) (hexutil.Bytes, error) {
+	out, err := api.simulate(types.QueryCall, args, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(out.Res)
+}
+
+// EstimateGas returns the gas a transaction would consume, eth_estimateGas
+func (api PublicAPI) EstimateGas(args CallArgs) (hexutil.Uint64, error) {
+	out, err := api.simulate(types.EstimateGas, args, LatestBlockNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	return hexutil.Uint64(out.Gas), nil
+}
+
+// GetLogs returns the logs matching a filter, eth_getLogs. The blocks in range
+// are scanned transaction by transaction through the vm logs querier.
+func (api PublicAPI) GetLogs(q FilterQuery) ([]RPCLog, error) {
+	if q.BlockHash != nil {
+		return nil, errors.New("filtering logs by blockHash is not supported, use fromBlock and toBlock")
+	}
+
+	f, err := q.toFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := api.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest)
+	if q.FromBlock != nil && *q.FromBlock != LatestBlockNumber {
+		from = q.FromBlock.Int64()
+	}
+	if q.ToBlock != nil && *q.ToBlock != LatestBlockNumber {
+		to = q.ToBlock.Int64()
+	}
+	if to > int64(latest) {
+		to = int64(latest)
+	}
+	if from > to {
+		return []RPCLog{}, nil
+	}
+	if to-from >= MaxLogsBlockRange {
+		return nil, fmt.Errorf("block range too large, at most %d blocks per request", MaxLogsBlockRange)
+	}
+
+	// the logs are found through the log index of the vm, the blocks are only fetched
+	// for the hash and the tx index of the logs found
+	logs, err := api.filterLogs(from, to, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]RPCLog, 0, len(logs))
+	blocks := make(map[uint64]*blockTxs)
+	for _, log := range logs {
+		b, ok := blocks[log.BlockNumber]
+		if !ok {
+			b, err = api.blockTxs(int64(log.BlockNumber))
+			if err != nil {
+				return nil, err
+			}
+			blocks[log.BlockNumber] = b
+		}
+
+		b.fill(log)
+		result = append(result, NewRPCLog(log))
+	}
+
+	return result, nil
+}
+
+// filterLogs pages through the vm logs of the block range matching the filter
+func (api PublicAPI) filterLogs(from, to int64, f filter) ([]*types.Log, error) {
+	var logs []*types.Log
+	for page := 1; ; page++ {
+		params := types.NewQueryFilterLogsParams(from, to, f.addresses, f.topics, page, logsPageLimit)
+		pageLogs, _, err := vmutils.QueryFilterLogs(api.cliCtx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, pageLogs...)
+		if len(pageLogs) < logsPageLimit {
+			return logs, nil
+		}
+	}
+}
+
+// blockTxs is the hash of a block, the hash of its parent and the position of its txs
+type blockTxs struct {
+	hash       sdk.Hash
+	parentHash sdk.Hash
+	txIndex    map[sdk.Hash]uint
+}
+
+// fill sets the block hash and the tx index of a log of the block
+func (b *blockTxs) fill(log *types.Log) {
+	log.BlockHash = b.hash
+	log.TxIndex = b.txIndex[log.TxHash]
+}
+
+func (api PublicAPI) blockTxs(height int64) (*blockTxs, error) {
+	node, err := api.cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := node.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &blockTxs{
+		hash:       sdk.BytesToHash(block.BlockMeta.BlockID.Hash),
+		parentHash: sdk.BytesToHash(block.Block.LastBlockID.Hash),
+		txIndex:    make(map[sdk.Hash]uint, len(block.Block.Data.Txs)),
+	}
+	// the logs of an Ethereum tx carry its Ethereum hash, the tx may be either kind
+	for i, tx := range block.Block.Data.Txs {
+		b.txIndex[sdk.BytesToHash(tmhash.Sum(tx))] = uint(i)
+		b.txIndex[types.EthTxHash(tx)] = uint(i)
+	}
+
+	return b, nil
+}
+
+// SendRawTransaction broadcasts an encoded signed transaction and returns its
+// Ethereum hash, the one its receipt and logs are stored under, eth_sendRawTransaction
+func (api PublicAPI) SendRawTransaction(data hexutil.Bytes) (ethcmn.Hash, error) {
+	res, err := api.cliCtx.BroadcastTxSync(data)
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+
+	if res.Code != 0 {
+		return ethcmn.Hash{}, errors.New(res.RawLog)
+	}
+
+	return ethcmn.Hash(types.EthTxHash(data)), nil
+}
+
+// TraceTransaction replays a committed tx and returns its struct logs, or its call
+// tree with the callTracer, debug_traceTransaction
+func (api PublicAPI) TraceTransaction(hash ethcmn.Hash, config types.TraceConfig) (json.RawMessage, error) {
+	res, err := vmutils.QueryTrace(api.cliCtx, hex.EncodeToString(hash.Bytes()), config)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(res), nil
+}
+
+func (api PublicAPI) chainID() (*big.Int, error) {
+	node, err := api.cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	return types.EthChainID(status.NodeInfo.Network), nil
+}
+
+func (api PublicAPI) simulate(path string, args CallArgs, bn BlockNumber) (out types.SimulationResult, err error) {
+	msg, err := args.toMsg()
+	if err != nil {
+		return
+	}
+
+	data, err := api.cliCtx.Codec.MarshalJSON(msg)
+	if err != nil {
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, _, err := api.cliCtx.WithHeight(bn.Int64()).QueryWithData(route, data)
+	if err != nil {
+		return
+	}
+
+	err = api.cliCtx.Codec.UnmarshalJSON(res, &out)
+	return
+}
+
+func (api PublicAPI) txLogs(txHash []byte) ([]*types.Log, error) {
+	route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTxLogs, hex.EncodeToString(txHash))
+	res, _, err := api.cliCtx.Query(route)
+	if err != nil {
+		return nil, err
+	}
+
+	var out types.QueryLogsResult
+	if err := api.cliCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Logs, nil
+}
@@ -0,0 +1,260 @@
+package ethrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+)
+
+// RPCPath is the LCD path the Ethereum JSON-RPC endpoint is served on
+const RPCPath = "/eth"
+
+// JSON-RPC 2.0 error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeServerError    = -32000
+)
+
+const jsonrpcVersion = "2.0"
+
+// Request is a JSON-RPC request
+type Request struct {
+	Version string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// Response is a JSON-RPC response
+type Response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC error object
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+type methodFn func(api PublicAPI, params []json.RawMessage) (interface{}, error)
+
+var methods = map[string]methodFn{
+	"web3_clientVersion": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		return api.ClientVersion(), nil
+	},
+	"web3_sha3": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var input hexutil.Bytes
+		if err := parseParams(params, 1, &input); err != nil {
+			return nil, err
+		}
+		return api.Sha3(input), nil
+	},
+	"net_version": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		return api.NetVersion()
+	},
+	"net_listening": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		return true, nil
+	},
+	"eth_chainId": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		return api.ChainID()
+	},
+	"eth_blockNumber": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		return api.BlockNumber()
+	},
+	"eth_getBalance": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var (
+			address string
+			bn      BlockNumber
+		)
+		if err := parseParams(params, 1, &address, &bn); err != nil {
+			return nil, err
+		}
+		return api.GetBalance(address, bn)
+	},
+	"eth_getCode": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var (
+			address string
+			bn      BlockNumber
+		)
+		if err := parseParams(params, 1, &address, &bn); err != nil {
+			return nil, err
+		}
+		return api.GetCode(address, bn)
+	},
+	"eth_getStorageAt": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var (
+			address, key string
+			bn           BlockNumber
+		)
+		if err := parseParams(params, 2, &address, &key, &bn); err != nil {
+			return nil, err
+		}
+		return api.GetStorageAt(address, key, bn)
+	},
+	"eth_call": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var (
+			args CallArgs
+			bn   BlockNumber
+		)
+		if err := parseParams(params, 1, &args, &bn); err != nil {
+			return nil, err
+		}
+		return api.Call(args, bn)
+	},
+	"eth_estimateGas": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var args CallArgs
+		if err := parseParams(params, 1, &args); err != nil {
+			return nil, err
+		}
+		return api.EstimateGas(args)
+	},
+	"eth_getLogs": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var q FilterQuery
+		if err := parseParams(params, 1, &q); err != nil {
+			return nil, err
+		}
+		return api.GetLogs(q)
+	},
+	"eth_sendRawTransaction": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var data hexutil.Bytes
+		if err := parseParams(params, 1, &data); err != nil {
+			return nil, err
+		}
+		return api.SendRawTransaction(data)
+	},
+	"debug_traceTransaction": func(api PublicAPI, params []json.RawMessage) (interface{}, error) {
+		var (
+			hash   ethcmn.Hash
+			config types.TraceConfig
+		)
+		if err := parseParams(params, 1, &hash, &config); err != nil {
+			return nil, err
+		}
+		return api.TraceTransaction(hash, config)
+	},
+}
+
+// RegisterRoutes registers the Ethereum JSON-RPC endpoint on the LCD router. The
+// endpoint is served over HTTP POST and over websocket, which adds eth_subscribe.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	api := NewPublicAPI(cliCtx)
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "ethrpc")
+
+	r.HandleFunc(RPCPath, rpcHandlerFn(api)).Methods("POST")
+	r.HandleFunc(RPCPath, wsHandlerFn(api, newLogsFeed(api, logger), logger)).Methods("GET")
+}
+
+func rpcHandlerFn(api PublicAPI) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeJSON(w, newErrorResponse(nil, CodeParseError, err.Error()))
+			return
+		}
+
+		writeJSON(w, handleBody(body, api.handle))
+	}
+}
+
+// handleBody decodes a request or a batch of requests and returns the response or
+// the batch of responses
+func handleBody(body []byte, handle func(Request) Response) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []Request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return newErrorResponse(nil, CodeParseError, err.Error())
+		}
+
+		resps := make([]Response, 0, len(reqs))
+		for _, req := range reqs {
+			resps = append(resps, handle(req))
+		}
+		return resps
+	}
+
+	var req Request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return newErrorResponse(nil, CodeParseError, err.Error())
+	}
+
+	return handle(req)
+}
+
+// handle dispatches a single request to its method
+func (api PublicAPI) handle(req Request) Response {
+	if req.Version != jsonrpcVersion || len(req.Method) == 0 {
+		return newErrorResponse(req.ID, CodeInvalidRequest, "invalid request")
+	}
+
+	fn, ok := methods[req.Method]
+	if !ok {
+		return newErrorResponse(req.ID, CodeMethodNotFound, fmt.Sprintf("the method %s does not exist/is not available", req.Method))
+	}
+
+	result, err := fn(api, req.Params)
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+
+	return Response{Version: jsonrpcVersion, ID: req.ID, Result: result}
+}
+
+// parseParams decodes the positional params into args, the first required of
+// them must be present
+func parseParams(params []json.RawMessage, required int, args ...interface{}) error {
+	if len(params) < required {
+		return &Error{Code: CodeInvalidParams, Message: fmt.Sprintf("missing value for required argument %d", len(params))}
+	}
+	if len(params) > len(args) {
+		return &Error{Code: CodeInvalidParams, Message: fmt.Sprintf("too many arguments, want at most %d", len(args))}
+	}
+
+	for i, param := range params {
+		if err := json.Unmarshal(param, args[i]); err != nil {
+			return &Error{Code: CodeInvalidParams, Message: fmt.Sprintf("invalid argument %d: %s", i, err.Error())}
+		}
+	}
+
+	return nil
+}
+
+func newErrorResponse(id json.RawMessage, code int, msg string) Response {
+	return Response{Version: jsonrpcVersion, ID: id, Error: &Error{Code: code, Message: msg}}
+}
+
+// errorResponse keeps the code of a JSON-RPC error, other errors are server errors
+func errorResponse(id json.RawMessage, err error) Response {
+	if rpcErr, ok := err.(*Error); ok {
+		return newErrorResponse(id, rpcErr.Code, rpcErr.Message)
+	}
+	return newErrorResponse(id, CodeServerError, err.Error())
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
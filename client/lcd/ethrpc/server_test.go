@@ -0,0 +1,102 @@
+package ethrpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+func TestBlockNumberUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected BlockNumber
+		expErr   bool
+	}{
+		{`"latest"`, LatestBlockNumber, false},
+		{`"pending"`, LatestBlockNumber, false},
+		{`"earliest"`, EarliestBlockNumber, false},
+		{`"0x10"`, BlockNumber(16), false},
+		{`"0x010"`, 0, true},
+		{`16`, 0, true},
+	}
+
+	for i, tc := range cases {
+		var bn BlockNumber
+		err := json.Unmarshal([]byte(tc.input), &bn)
+		if tc.expErr {
+			require.Error(t, err, "case %d", i)
+			continue
+		}
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, tc.expected, bn, "case %d", i)
+	}
+}
+
+func TestAccAddressHexRoundTrip(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	res, err := AccAddressFromHex(AccAddressToHex(addr))
+	require.NoError(t, err)
+	require.Equal(t, addr, res)
+
+	_, err = AccAddressFromHex("0x1234")
+	require.Error(t, err)
+}
+
+func TestFilterMatch(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	topicA := sdk.BytesToHash([]byte("A"))
+	topicB := sdk.BytesToHash([]byte("B"))
+	topicC := sdk.BytesToHash([]byte("C"))
+
+	log := &types.Log{Address: addr1, Topics: []sdk.Hash{topicA, topicB}}
+
+	cases := []struct {
+		query    string
+		expected bool
+	}{
+		{`{}`, true},
+		{`{"address":"` + AccAddressToHex(addr1) + `"}`, true},
+		{`{"address":["` + AccAddressToHex(addr2) + `","` + AccAddressToHex(addr1) + `"]}`, true},
+		{`{"address":"` + AccAddressToHex(addr2) + `"}`, false},
+		{`{"topics":["` + hexutil.Encode(topicA.Bytes()) + `"]}`, true},
+		{`{"topics":[null,"` + hexutil.Encode(topicB.Bytes()) + `"]}`, true},
+		{`{"topics":[["` + hexutil.Encode(topicC.Bytes()) + `","` + hexutil.Encode(topicA.Bytes()) + `"]]}`, true},
+		{`{"topics":["` + hexutil.Encode(topicB.Bytes()) + `"]}`, false},
+		{`{"topics":[null,null,null]}`, false},
+	}
+
+	for i, tc := range cases {
+		var q FilterQuery
+		require.NoError(t, json.Unmarshal([]byte(tc.query), &q), "case %d", i)
+		f, err := q.toFilter()
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, tc.expected, f.match(log), "case %d", i)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	api := NewPublicAPI(context.CLIContext{})
+
+	res := api.handle(Request{Version: "1.0", Method: "web3_clientVersion"})
+	require.Equal(t, CodeInvalidRequest, res.Error.Code)
+
+	res = api.handle(Request{Version: jsonrpcVersion, Method: "eth_unknown"})
+	require.Equal(t, CodeMethodNotFound, res.Error.Code)
+
+	res = api.handle(Request{Version: jsonrpcVersion, Method: "eth_getCode"})
+	require.Equal(t, CodeInvalidParams, res.Error.Code)
+
+	res = api.handle(Request{Version: jsonrpcVersion, Method: "web3_sha3", Params: []json.RawMessage{[]byte(`"0x"`)}})
+	require.Nil(t, res.Error)
+	d, err := json.Marshal(res.Result)
+	require.NoError(t, err)
+	require.Equal(t, `"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"`, string(d))
+}
@@ -0,0 +1,266 @@
+package ethrpc
+
+import (
+	gocontext "context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+)
+
+const (
+	// maxRememberedBlocks is the number of pushed blocks kept to report their logs as
+	// removed when they are replaced
+	maxRememberedBlocks = 128
+
+	// maxCatchUpBlocks is the largest number of blocks fetched at once to catch up with
+	// the node after the blocks were missed, e.g. while reconnecting
+	maxCatchUpBlocks = 128
+
+	// subscriptionBuffer is the number of logs buffered for a subscription before it
+	// is dropped for being too slow
+	subscriptionBuffer = 1024
+
+	reconnectDelay   = 3 * time.Second
+	subscribeTimeout = 10 * time.Second
+	feedSubscriber   = "ethrpc-logs"
+)
+
+// blockLogs is a block pushed to the logs subscriptions
+type blockLogs struct {
+	*blockTxs
+	height int64
+	logs   []*types.Log
+}
+
+// logsSubscription is an eth_subscribe("logs") subscription, the matching logs are
+// delivered on the logs channel which is closed once the subscription is dropped
+type logsSubscription struct {
+	id     string
+	filter filter
+	logs   chan RPCLog
+}
+
+// logsFeed follows the new blocks of the node and pushes the vm logs of each block
+// to the logs subscriptions. The last blocks pushed are remembered, when one of them
+// is replaced, e.g. after a failed block replay, its logs are pushed again with
+// removed set before the logs of the block replacing it.
+type logsFeed struct {
+	cliCtx context.CLIContext
+	logger log.Logger
+	fetch  func(height int64) (*blockLogs, error)
+
+	once   sync.Once
+	mtx    sync.Mutex
+	subs   map[string]*logsSubscription
+	blocks []*blockLogs
+}
+
+func newLogsFeed(api PublicAPI, logger log.Logger) *logsFeed {
+	return &logsFeed{
+		cliCtx: api.cliCtx,
+		logger: logger,
+		fetch:  api.blockLogs,
+		subs:   make(map[string]*logsSubscription),
+	}
+}
+
+// subscribe adds a subscription to the logs matching the filter, the feed starts
+// following the node with the first subscription
+func (feed *logsFeed) subscribe(f filter) *logsSubscription {
+	feed.once.Do(func() {
+		go feed.run()
+	})
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+
+	sub := &logsSubscription{
+		id:     hexutil.Encode(id),
+		filter: f,
+		logs:   make(chan RPCLog, subscriptionBuffer),
+	}
+
+	feed.mtx.Lock()
+	feed.subs[sub.id] = sub
+	feed.mtx.Unlock()
+
+	return sub
+}
+
+// unsubscribe drops a subscription and reports whether it existed
+func (feed *logsFeed) unsubscribe(id string) bool {
+	feed.mtx.Lock()
+	defer feed.mtx.Unlock()
+
+	sub, ok := feed.subs[id]
+	if ok {
+		delete(feed.subs, id)
+		close(sub.logs)
+	}
+	return ok
+}
+
+// run follows the node and reconnects when the connection is lost
+func (feed *logsFeed) run() {
+	for {
+		if err := feed.follow(); err != nil {
+			feed.logger.Error("lost the new blocks of the node, reconnecting", "err", err)
+		}
+		time.Sleep(reconnectDelay)
+	}
+}
+
+// follow subscribes to the new blocks of the node and pushes them until the
+// connection is lost. The blocks missed while disconnected are caught up with the
+// first new block.
+func (feed *logsFeed) follow() error {
+	client := rpcclient.NewHTTP(feed.cliCtx.NodeURI, "/websocket")
+	client.SetLogger(feed.logger)
+	if err := client.Start(); err != nil {
+		return err
+	}
+	defer client.Stop()
+
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), subscribeTimeout)
+	defer cancel()
+
+	events, err := client.Subscribe(ctx, feedSubscriber, tmtypes.EventQueryNewBlock.String(), maxCatchUpBlocks)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event := <-events:
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			if err := feed.newBlock(data.Block.Height); err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("connection to the node closed")
+		}
+	}
+}
+
+// newBlock pushes the blocks up to the height which were not pushed yet
+func (feed *logsFeed) newBlock(height int64) error {
+	feed.mtx.Lock()
+	defer feed.mtx.Unlock()
+
+	var b *blockLogs
+	for {
+		next := height
+		if top := feed.top(); top != nil && top.height < height-1 {
+			next = top.height + 1
+			if height-next >= maxCatchUpBlocks {
+				// too far behind, the blocks in between are skipped
+				feed.blocks = nil
+				next = height
+			}
+		}
+
+		if b == nil || b.height != next {
+			var err error
+			if b, err = feed.fetch(next); err != nil {
+				return err
+			}
+		}
+
+		if !feed.push(b) {
+			continue
+		}
+		if next == height {
+			return nil
+		}
+	}
+}
+
+// push puts a block on top of the pushed blocks and sends its logs. A pushed block
+// at the height of the block or above it, or right under it but not its parent, was
+// replaced. The top block is then popped, its logs are sent with removed set, and
+// false is returned for the blocks from its height to be fetched again.
+func (feed *logsFeed) push(b *blockLogs) bool {
+	top := feed.top()
+	switch {
+	case top == nil, top.height == b.height-1 && top.hash == b.parentHash:
+		feed.blocks = append(feed.blocks, b)
+		if len(feed.blocks) > maxRememberedBlocks {
+			feed.blocks = feed.blocks[1:]
+		}
+		feed.send(b.logs, false)
+		return true
+
+	case top.height == b.height && top.hash == b.hash:
+		// already pushed, e.g. the block is sent again after reconnecting
+		return true
+
+	default:
+		feed.blocks = feed.blocks[:len(feed.blocks)-1]
+		feed.send(top.logs, true)
+		return false
+	}
+}
+
+func (feed *logsFeed) top() *blockLogs {
+	if len(feed.blocks) == 0 {
+		return nil
+	}
+	return feed.blocks[len(feed.blocks)-1]
+}
+
+// send delivers the logs to the subscriptions they match, a subscription whose
+// buffer is full is dropped
+func (feed *logsFeed) send(logs []*types.Log, removed bool) {
+	for id, sub := range feed.subs {
+	LOGS:
+		for _, log := range logs {
+			if !sub.filter.match(log) {
+				continue
+			}
+
+			rpcLog := NewRPCLog(log)
+			rpcLog.Removed = removed
+			select {
+			case sub.logs <- rpcLog:
+			default:
+				feed.logger.Error("dropping a too slow logs subscription", "id", id)
+				delete(feed.subs, id)
+				close(sub.logs)
+				break LOGS
+			}
+		}
+	}
+}
+
+// blockLogs fetches a block and all its vm logs
+func (api PublicAPI) blockLogs(height int64) (*blockLogs, error) {
+	b, err := api.blockTxs(height)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := api.filterLogs(height, height, filter{})
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range logs {
+		b.fill(log)
+	}
+
+	return &blockLogs{blockTxs: b, height: height, logs: logs}, nil
+}
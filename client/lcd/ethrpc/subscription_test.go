@@ -0,0 +1,173 @@
+package ethrpc
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/context"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// testChain serves the blocks of a chain whose blocks can be replaced, each block
+// has one log carrying the block hash as its topic
+type testChain map[int64]*blockLogs
+
+func (c testChain) set(height int64, fork string) {
+	hash := sdk.BytesToHash([]byte(fork + string(rune('0'+height))))
+	var parentHash sdk.Hash
+	if parent, ok := c[height-1]; ok {
+		parentHash = parent.hash
+	}
+
+	c[height] = &blockLogs{
+		blockTxs: &blockTxs{hash: hash, parentHash: parentHash},
+		height:   height,
+		logs: []*types.Log{{
+			Address:     sdk.AccAddress([]byte("addr1_______________")),
+			Topics:      []sdk.Hash{hash},
+			BlockNumber: uint64(height),
+			BlockHash:   hash,
+		}},
+	}
+}
+
+func (c testChain) fetch(height int64) (*blockLogs, error) {
+	return c[height], nil
+}
+
+func newTestFeed(chain testChain) *logsFeed {
+	feed := newLogsFeed(NewPublicAPI(context.CLIContext{}), log.NewNopLogger())
+	feed.fetch = chain.fetch
+	// the feed is driven by the test instead of following a node
+	feed.once.Do(func() {})
+	return feed
+}
+
+func receive(t *testing.T, sub *logsSubscription, n int) []RPCLog {
+	logs := make([]RPCLog, n)
+	for i := range logs {
+		select {
+		case logs[i] = <-sub.logs:
+		default:
+			t.Fatalf("expected %d logs, got %d", n, i)
+		}
+	}
+	require.Len(t, sub.logs, 0)
+	return logs
+}
+
+func TestLogsFeed(t *testing.T) {
+	chain := make(testChain)
+	for h := int64(1); h <= 5; h++ {
+		chain.set(h, "a")
+	}
+
+	feed := newTestFeed(chain)
+	sub := feed.subscribe(filter{})
+
+	// the first block is pushed alone, the missed blocks are caught up
+	require.NoError(t, feed.newBlock(2))
+	require.NoError(t, feed.newBlock(4))
+	logs := receive(t, sub, 3)
+	for i, h := range []uint64{2, 3, 4} {
+		require.Equal(t, h, uint64(logs[i].BlockNumber))
+		require.False(t, logs[i].Removed)
+	}
+
+	// a block sent again is not pushed twice
+	require.NoError(t, feed.newBlock(4))
+	receive(t, sub, 0)
+
+	// blocks 3 and 4 are replaced, their logs are removed before the new ones are pushed
+	chain.set(3, "b")
+	chain.set(4, "b")
+	chain.set(5, "b")
+	require.NoError(t, feed.newBlock(5))
+	logs = receive(t, sub, 5)
+	require.True(t, logs[0].Removed)
+	require.Equal(t, uint64(4), uint64(logs[0].BlockNumber))
+	require.True(t, logs[1].Removed)
+	require.Equal(t, uint64(3), uint64(logs[1].BlockNumber))
+	for i, h := range []uint64{3, 4, 5} {
+		require.False(t, logs[i+2].Removed)
+		require.Equal(t, h, uint64(logs[i+2].BlockNumber))
+		require.Equal(t, chain[int64(h)].hash.Bytes(), logs[i+2].BlockHash.Bytes())
+	}
+
+	// the height going back removes the blocks above it
+	chain.set(4, "c")
+	require.NoError(t, feed.newBlock(4))
+	logs = receive(t, sub, 3)
+	require.True(t, logs[0].Removed)
+	require.Equal(t, uint64(5), uint64(logs[0].BlockNumber))
+	require.True(t, logs[1].Removed)
+	require.Equal(t, uint64(4), uint64(logs[1].BlockNumber))
+	require.False(t, logs[2].Removed)
+	require.Equal(t, chain[4].hash.Bytes(), logs[2].BlockHash.Bytes())
+
+	// only the matching logs are delivered
+	other := feed.subscribe(filter{topics: [][]sdk.Hash{{sdk.BytesToHash([]byte("none"))}}})
+	chain.set(5, "c")
+	require.NoError(t, feed.newBlock(5))
+	receive(t, sub, 1)
+	receive(t, other, 0)
+
+	require.True(t, feed.unsubscribe(sub.id))
+	require.False(t, feed.unsubscribe(sub.id))
+	_, ok := <-sub.logs
+	require.False(t, ok)
+}
+
+func TestWebsocketSubscribe(t *testing.T) {
+	chain := make(testChain)
+	chain.set(1, "a")
+
+	api := NewPublicAPI(context.CLIContext{})
+	feed := newTestFeed(chain)
+	server := httptest.NewServer(wsHandlerFn(api, feed, log.NewNopLogger()))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`)))
+	var res Response
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, CodeInvalidParams, res.Error.Code)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"eth_subscribe","params":["logs",{"address":"`+AccAddressToHex(sdk.AccAddress([]byte("addr1_______________")))+`"}]}`)))
+	res = Response{}
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Nil(t, res.Error)
+	id, ok := res.Result.(string)
+	require.True(t, ok)
+
+	require.NoError(t, feed.newBlock(1))
+	var n struct {
+		Method string `json:"method"`
+		Params struct {
+			Subscription string `json:"subscription"`
+			Result       RPCLog `json:"result"`
+		} `json:"params"`
+	}
+	require.NoError(t, conn.ReadJSON(&n))
+	require.Equal(t, "eth_subscription", n.Method)
+	require.Equal(t, id, n.Params.Subscription)
+	require.Equal(t, chain[1].hash.Bytes(), n.Params.Result.BlockHash.Bytes())
+
+	// the other methods are served as over http
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`[{"jsonrpc":"2.0","id":3,"method":"eth_unsubscribe","params":["`+id+`"]},{"jsonrpc":"2.0","id":4,"method":"web3_sha3","params":["0x"]}]`)))
+	var resps []json.RawMessage
+	require.NoError(t, conn.ReadJSON(&resps))
+	require.Len(t, resps, 2)
+	require.Contains(t, string(resps[0]), `"result":true`)
+	require.Contains(t, string(resps[1]), `0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470`)
+}
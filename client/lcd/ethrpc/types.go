@@ -0,0 +1,252 @@
+package ethrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// BlockNumber is the height parsed from an Ethereum block tag. Zero means the
+// latest height, as for CLIContext.WithHeight.
+type BlockNumber int64
+
+const (
+	LatestBlockNumber   = BlockNumber(0)
+	EarliestBlockNumber = BlockNumber(1)
+)
+
+// UnmarshalJSON parses a block tag: "latest", "pending", "earliest" or a hex quantity.
+func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
+	var input string
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+
+	switch strings.TrimSpace(input) {
+	case "", "latest", "pending":
+		*bn = LatestBlockNumber
+		return nil
+	case "earliest":
+		*bn = EarliestBlockNumber
+		return nil
+	}
+
+	height, err := hexutil.DecodeUint64(input)
+	if err != nil {
+		return err
+	}
+	if height > uint64(1<<63-1) {
+		return fmt.Errorf("block number %d too large", height)
+	}
+
+	*bn = BlockNumber(height)
+	return nil
+}
+
+// Int64 returns the block number as a tendermint height
+func (bn BlockNumber) Int64() int64 {
+	return int64(bn)
+}
+
+// CallArgs represents the arguments of eth_call and eth_estimateGas
+type CallArgs struct {
+	From     *string         `json:"from"`
+	To       *string         `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+
+	AccessList *types.EthAccessList `json:"accessList"`
+}
+
+// toMsg converts the call arguments into a vm query message
+func (args CallArgs) toMsg() (types.MsgContractQuery, error) {
+	var (
+		msg types.MsgContractQuery
+		err error
+	)
+
+	msg.From = make(sdk.AccAddress, sdk.AddrLen)
+	if args.From != nil {
+		if msg.From, err = AccAddressFromHex(*args.From); err != nil {
+			return msg, err
+		}
+	}
+
+	if args.To != nil {
+		if msg.To, err = AccAddressFromHex(*args.To); err != nil {
+			return msg, err
+		}
+	}
+
+	msg.Amount = sdk.NewCoin(sdk.NativeTokenName, sdk.ZeroInt())
+	if args.Value != nil {
+		msg.Amount = sdk.NewCoin(sdk.NativeTokenName, sdk.NewIntFromBigInt(args.Value.ToInt()))
+	}
+
+	switch {
+	case args.Input != nil:
+		msg.Payload = []byte(*args.Input)
+	case args.Data != nil:
+		msg.Payload = []byte(*args.Data)
+	}
+
+	if args.AccessList != nil {
+		msg.AccessList = args.AccessList.ToAccessList()
+	}
+
+	return msg, nil
+}
+
+// FilterQuery represents the argument of eth_getLogs
+type FilterQuery struct {
+	BlockHash *ethcmn.Hash  `json:"blockHash"`
+	FromBlock *BlockNumber  `json:"fromBlock"`
+	ToBlock   *BlockNumber  `json:"toBlock"`
+	Address   interface{}   `json:"address"`
+	Topics    []interface{} `json:"topics"`
+}
+
+// filter is the decoded form of a FilterQuery
+type filter struct {
+	addresses []sdk.AccAddress
+	topics    [][]sdk.Hash
+}
+
+// toFilter decodes the address and topic criteria of the query
+func (q FilterQuery) toFilter() (f filter, err error) {
+	switch addr := q.Address.(type) {
+	case nil:
+	case string:
+		a, err := AccAddressFromHex(addr)
+		if err != nil {
+			return f, err
+		}
+		f.addresses = append(f.addresses, a)
+	case []interface{}:
+		for _, item := range addr {
+			s, ok := item.(string)
+			if !ok {
+				return f, fmt.Errorf("invalid address %v", item)
+			}
+			a, err := AccAddressFromHex(s)
+			if err != nil {
+				return f, err
+			}
+			f.addresses = append(f.addresses, a)
+		}
+	default:
+		return f, fmt.Errorf("invalid address filter %v", addr)
+	}
+
+	for _, topic := range q.Topics {
+		switch t := topic.(type) {
+		case nil:
+			f.topics = append(f.topics, nil)
+		case string:
+			h, err := hexToHash(t)
+			if err != nil {
+				return f, err
+			}
+			f.topics = append(f.topics, []sdk.Hash{sdk.Hash(h)})
+		case []interface{}:
+			var position []sdk.Hash
+			for _, item := range t {
+				if item == nil {
+					position = nil
+					break
+				}
+				s, ok := item.(string)
+				if !ok {
+					return f, fmt.Errorf("invalid topic %v", item)
+				}
+				h, err := hexToHash(s)
+				if err != nil {
+					return f, err
+				}
+				position = append(position, sdk.Hash(h))
+			}
+			f.topics = append(f.topics, position)
+		default:
+			return f, fmt.Errorf("invalid topic filter %v", t)
+		}
+	}
+
+	return f, nil
+}
+
+// logFilter returns the vm log filter of the criteria over a block range
+func (f filter) logFilter(from, to uint64) types.LogFilter {
+	return types.NewLogFilter(from, to, f.addresses, f.topics)
+}
+
+// match reports whether the log satisfies the address and topic criteria. Topics
+// are matched by position, any of the hashes at a position matches and an empty
+// position matches everything.
+func (f filter) match(log *types.Log) bool {
+	return f.logFilter(0, math.MaxUint64).Match(log)
+}
+
+// RPCLog is the Ethereum JSON representation of a vm log
+type RPCLog struct {
+	Address     string         `json:"address"`
+	Topics      []ethcmn.Hash  `json:"topics"`
+	Data        hexutil.Bytes  `json:"data"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      ethcmn.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash   ethcmn.Hash    `json:"blockHash"`
+	Index       hexutil.Uint64 `json:"logIndex"`
+	Removed     bool           `json:"removed"`
+}
+
+// NewRPCLog converts a vm log into its Ethereum JSON representation
+func NewRPCLog(log *types.Log) RPCLog {
+	topics := make([]ethcmn.Hash, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = ethcmn.Hash(topic)
+	}
+
+	return RPCLog{
+		Address:     AccAddressToHex(log.Address),
+		Topics:      topics,
+		Data:        []byte(log.Data),
+		BlockNumber: hexutil.Uint64(log.BlockNumber),
+		TxHash:      ethcmn.Hash(log.TxHash),
+		TxIndex:     hexutil.Uint64(log.TxIndex),
+		BlockHash:   ethcmn.Hash(log.BlockHash),
+		Index:       hexutil.Uint64(log.Index),
+		Removed:     log.Removed,
+	}
+}
+
+// AccAddressFromHex converts a 0x prefixed hex address into an AccAddress
+func AccAddressFromHex(address string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromHex(strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X"))
+	if err != nil {
+		return nil, err
+	}
+	if len(addr) != sdk.AddrLen {
+		return nil, fmt.Errorf("invalid address length %d of %s", len(addr), address)
+	}
+	return addr, nil
+}
+
+// AccAddressToHex converts an AccAddress into a 0x prefixed hex address
+func AccAddressToHex(addr sdk.AccAddress) string {
+	return hexutil.Encode(addr.Bytes())
+}
+
+func hexToHash(s string) (h ethcmn.Hash, err error) {
+	err = h.UnmarshalText([]byte(s))
+	return
+}
@@ -0,0 +1,200 @@
+package ethrpc
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	wsWriteWait  = 10 * time.Second
+	wsPongWait   = 60 * time.Second
+	wsPingPeriod = wsPongWait * 9 / 10
+
+	// LogsSubscription is the only subscription kind of eth_subscribe
+	LogsSubscription = "logs"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Notification is the eth_subscription message pushing a result of a subscription
+type Notification struct {
+	Version string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  SubscriptionResult `json:"params"`
+}
+
+// SubscriptionResult is a result of a subscription
+type SubscriptionResult struct {
+	Subscription string      `json:"subscription"`
+	Result       interface{} `json:"result"`
+}
+
+// wsSession is a websocket connection of a client and its subscriptions
+type wsSession struct {
+	api    PublicAPI
+	feed   *logsFeed
+	logger log.Logger
+
+	conn *websocket.Conn
+	mtx  sync.Mutex
+	subs map[string]struct{}
+	// subscriptions created by the request being handled, they start pushing once
+	// the response carrying their id was written
+	pending []*logsSubscription
+}
+
+func wsHandlerFn(api PublicAPI, feed *logsFeed, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has already replied with an error
+			return
+		}
+
+		s := &wsSession{
+			api:    api,
+			feed:   feed,
+			logger: logger,
+			conn:   conn,
+			subs:   make(map[string]struct{}),
+		}
+		s.serve()
+	}
+}
+
+// serve handles the requests of the client until the connection is closed, then
+// drops its subscriptions
+func (s *wsSession) serve() {
+	defer func() {
+		for id := range s.subs {
+			s.feed.unsubscribe(id)
+		}
+		s.conn.Close()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go s.ping(done)
+
+	_ = s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, body, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				s.logger.Error("websocket read failed", "err", err)
+			}
+			return
+		}
+		_ = s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		if err := s.write(handleBody(body, s.handle)); err != nil {
+			return
+		}
+
+		for _, sub := range s.pending {
+			go s.forward(sub)
+		}
+		s.pending = nil
+	}
+}
+
+// handle serves the subscription methods and hands the others to the api
+func (s *wsSession) handle(req Request) Response {
+	if req.Version != jsonrpcVersion {
+		return s.api.handle(req)
+	}
+
+	switch req.Method {
+	case "eth_subscribe":
+		var (
+			kind string
+			q    FilterQuery
+		)
+		if err := parseParams(req.Params, 1, &kind, &q); err != nil {
+			return errorResponse(req.ID, err)
+		}
+		if kind != LogsSubscription {
+			return newErrorResponse(req.ID, CodeInvalidParams, fmt.Sprintf("unsupported subscription %s", kind))
+		}
+
+		f, err := q.toFilter()
+		if err != nil {
+			return newErrorResponse(req.ID, CodeInvalidParams, err.Error())
+		}
+
+		sub := s.feed.subscribe(f)
+		s.subs[sub.id] = struct{}{}
+		s.pending = append(s.pending, sub)
+		return Response{Version: jsonrpcVersion, ID: req.ID, Result: sub.id}
+
+	case "eth_unsubscribe":
+		var id string
+		if err := parseParams(req.Params, 1, &id); err != nil {
+			return errorResponse(req.ID, err)
+		}
+
+		_, ok := s.subs[id]
+		if ok {
+			delete(s.subs, id)
+			s.feed.unsubscribe(id)
+		}
+		return Response{Version: jsonrpcVersion, ID: req.ID, Result: ok}
+
+	default:
+		return s.api.handle(req)
+	}
+}
+
+// forward pushes the logs of a subscription to the client until it is dropped
+func (s *wsSession) forward(sub *logsSubscription) {
+	for rpcLog := range sub.logs {
+		n := Notification{
+			Version: jsonrpcVersion,
+			Method:  "eth_subscription",
+			Params:  SubscriptionResult{Subscription: sub.id, Result: rpcLog},
+		}
+		if err := s.write(n); err != nil {
+			return
+		}
+	}
+}
+
+// ping keeps the connection alive until done is closed
+func (s *wsSession) ping(done <-chan struct{}) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (s *wsSession) write(v interface{}) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return s.conn.WriteJSON(v)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/Dipper-Labs/Dipper-Protocol/client"
 	"github.com/Dipper-Labs/Dipper-Protocol/client/keys"
 	"github.com/Dipper-Labs/Dipper-Protocol/client/lcd"
+	"github.com/Dipper-Labs/Dipper-Protocol/client/lcd/ethrpc"
 	"github.com/Dipper-Labs/Dipper-Protocol/client/rpc"
 	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
 	"github.com/Dipper-Labs/Dipper-Protocol/version"
@@ -72,6 +73,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
 	v0.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
+	ethrpc.RegisterRoutes(rs.CliCtx, rs.Mux)
 }
 
 func queryCmd(cdc *amino.Codec) *cobra.Command {
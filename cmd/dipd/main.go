@@ -57,6 +57,7 @@ func main() {
 	rootCmd.AddCommand(guardian.AddGenesisGuardianCmd(ctx, cdc, app.DefaultNodeHome))
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 	rootCmd.AddCommand(replayCmd())
+	rootCmd.AddCommand(vmCmd(ctx, cdc))
 	rootCmd.AddCommand(client.LineBreak)
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
@@ -70,10 +71,11 @@ func main() {
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
 	minGasPrices := viper.GetString(flagMinGasPrices)
-	return app.NewDIPApp(
+	dipApp := app.NewDIPApp(
 		logger, db, traceStore, true, invCheckPeriod,
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))), baseapp.SetMinGasPrices(minGasPrices),
 	)
+	return newUpgradeWatcher(dipApp, logger)
 }
 
 func exportAppStateAndTMValidators(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string) (json.RawMessage, []tmtypes.GenesisValidator, error) {
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app"
+	"github.com/Dipper-Labs/Dipper-Protocol/server/config"
+	"github.com/Dipper-Labs/Dipper-Protocol/server/upgrade"
+)
+
+// newUpgradeWatcher wraps the app with the watcher of the upgrades scheduled by software upgrade proposals
+func newUpgradeWatcher(dipApp *app.DIPApp, logger log.Logger) abci.Application {
+	conf, err := config.ParseConfig()
+	if err != nil {
+		panic(err)
+	}
+
+	home := viper.GetString(cli.HomeFlag)
+	return upgrade.NewWatcher(dipApp, dipApp.UpgradeConfig, upgrade.Config{
+		InfoFile:     homePath(home, conf.Upgrade.InfoFile),
+		BlocksBefore: conf.Upgrade.BlocksBefore,
+		Binary:       homePath(home, conf.Upgrade.Binary),
+		AutoDownload: conf.Upgrade.AutoDownload,
+		Exec:         conf.Upgrade.Exec,
+	}, logger)
+}
+
+// homePath resolves the path relative to the home directory of the node
+func homePath(home, path string) string {
+	if len(path) == 0 || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(home, path)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Dipper-Labs/Dipper-Protocol/app"
+	"github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm"
+	vmtypes "github.com/Dipper-Labs/Dipper-Protocol/app/v0/vm/types"
+	"github.com/Dipper-Labs/Dipper-Protocol/codec"
+	"github.com/Dipper-Labs/Dipper-Protocol/server"
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+const (
+	flagVMHeight    = "height"
+	flagVMChunkSize = "chunk-size"
+)
+
+func vmCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vm",
+		Short: "vm state snapshot subcommands",
+	}
+
+	cmd.AddCommand(
+		exportVMStateCmd(ctx),
+		vm.ImportStateCmd(ctx, cdc, app.DefaultNodeHome),
+		vm.SnapshotToGethAllocCmd(),
+		vm.GethAllocToSnapshotCmd(),
+	)
+
+	return cmd
+}
+
+func exportVMStateCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state [snapshot]",
+		Short: "Export the codes, storage, nonces and balances of the vm into a snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := viper.GetInt64(flagVMHeight)
+			dipApp := app.NewDIPApp(ctx.Logger, db, nil, height == -1, uint(1))
+			if height != -1 {
+				if err := dipApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+			if dipApp.LastBlockHeight() == 0 {
+				return fmt.Errorf("state is not initialized")
+			}
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := dipApp.ExportVMSnapshot(f, viper.GetInt(flagVMChunkSize)); err != nil {
+				return fmt.Errorf("error exporting vm state: %v", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "vm state at height %d exported to %s\n", dipApp.LastBlockHeight(), args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagVMHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Int(flagVMChunkSize, vmtypes.DefaultSnapshotChunkSize, "payload size of the chunks of the snapshot in bytes")
+	return cmd
+}
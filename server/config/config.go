@@ -9,6 +9,9 @@ import (
 
 const (
 	defaultMinGasPrices = "1.0" + sdk.NativeTokenName
+
+	defaultUpgradeInfoFile     = "data/upgrade-info.json"
+	defaultUpgradeBlocksBefore = 10
 )
 
 // BaseConfig defines the server's basic configuration
@@ -34,9 +37,33 @@ type BaseConfig struct {
 	HaltTime uint64 `mapstructure:"halt-time"`
 }
 
+// UpgradeConfig defines the configuration of the watcher of the scheduled software upgrades
+type UpgradeConfig struct {
+	// InfoFile is the path of the upgrade-info file written as the switch height
+	// approaches, relative to the home directory of the node.
+	InfoFile string `mapstructure:"info-file"`
+
+	// BlocksBefore is how many blocks before the switch height the upgrade-info
+	// file is written and the replacement binary executed.
+	BlocksBefore uint64 `mapstructure:"blocks-before"`
+
+	// Binary is the path of the replacement binary, its checksum must match the
+	// checksum of the binary of the node platform in the upgrade proposal.
+	Binary string `mapstructure:"binary"`
+
+	// AutoDownload fetches the replacement binary from the url of the upgrade
+	// proposal when it is missing.
+	AutoDownload bool `mapstructure:"auto-download"`
+
+	// Exec replaces the running node with the replacement binary once verified.
+	Exec bool `mapstructure:"exec"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	Upgrade UpgradeConfig `mapstructure:"upgrade"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -72,5 +99,9 @@ func DefaultConfig() *Config {
 		BaseConfig{
 			MinGasPrices: defaultMinGasPrices,
 		},
+		UpgradeConfig{
+			InfoFile:     defaultUpgradeInfoFile,
+			BlocksBefore: defaultUpgradeBlocksBefore,
+		},
 	}
 }
@@ -21,6 +21,28 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # HaltHeight contains a non-zero height at which a node will gracefully halt
 # and shutdown that can be used to assist upgrades and testing.
 halt-height = {{ .BaseConfig.HaltHeight }}
+
+##### software upgrade options #####
+[upgrade]
+
+# The upgrade-info file written as the switch height of a scheduled upgrade
+# approaches, relative to the home directory of the node.
+info-file = "{{ .Upgrade.InfoFile }}"
+
+# How many blocks before the switch height the upgrade-info file is written
+# and the replacement binary executed.
+blocks-before = {{ .Upgrade.BlocksBefore }}
+
+# The replacement binary, its checksum must match the checksum the upgrade
+# proposal carries for the platform of the node.
+binary = "{{ .Upgrade.Binary }}"
+
+# Fetch the replacement binary from the url of the upgrade proposal when it
+# is missing, the url may point to a local file server.
+auto-download = {{ .Upgrade.AutoDownload }}
+
+# Replace the running node with the replacement binary once verified.
+exec = {{ .Upgrade.Exec }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,139 @@
+package upgrade
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// Info is the content of the upgrade-info file
+type Info struct {
+	ProposalID uint64              `json:"proposal_id"`
+	Version    uint64              `json:"version"`
+	Height     uint64              `json:"height"`
+	Software   string              `json:"software"`
+	Binaries   []sdk.UpgradeBinary `json:"binaries,omitempty"`
+	Info       json.RawMessage     `json:"info,omitempty"`
+}
+
+// NewInfo creates the upgrade info of a scheduled upgrade
+func NewInfo(upgradeConfig sdk.UpgradeConfig) Info {
+	info := Info{
+		ProposalID: upgradeConfig.ProposalID,
+		Version:    upgradeConfig.Protocol.Version,
+		Height:     upgradeConfig.Protocol.Height,
+		Software:   upgradeConfig.Protocol.Software,
+		Binaries:   upgradeConfig.Protocol.Binaries,
+	}
+	if len(upgradeConfig.Protocol.Info) != 0 {
+		info.Info = json.RawMessage(upgradeConfig.Protocol.Info)
+	}
+	return info
+}
+
+// WriteInfo writes the upgrade info to the file
+func WriteInfo(file string, info Info) error {
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bz, 0644)
+}
+
+// PlatformBinary returns the binary built for the platform
+func PlatformBinary(binaries []sdk.UpgradeBinary, platform string) (sdk.UpgradeBinary, bool) {
+	for _, binary := range binaries {
+		if binary.Platform == platform {
+			return binary, true
+		}
+	}
+	return sdk.UpgradeBinary{}, false
+}
+
+// VerifyFile checks the content of the file matches the checksum of the binary
+func VerifyFile(file string, binary sdk.UpgradeBinary) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return verify(f, binary)
+}
+
+// Download fetches the binary into the file once its checksum is verified, the url is either
+// an http(s) url, e.g. of a local file server, or a file url
+func Download(binary sdk.UpgradeBinary, file string) error {
+	transport := &http.Transport{}
+	transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(binary.URL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", binary.URL, resp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = verify(io.TeeReader(resp.Body, tmp), binary)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+func verify(r io.Reader, binary sdk.UpgradeBinary) error {
+	algo, digest, err := binary.ParseChecksum()
+	if err != nil {
+		return err
+	}
+
+	var h hash.Hash
+	switch algo {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+	if !bytes.Equal(h.Sum(nil), digest) {
+		return fmt.Errorf("checksum mismatch of the %s binary, expected %s", binary.Platform, binary.Checksum)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// +build !windows
+
+package upgrade
+
+import "syscall"
+
+var execBinary = syscall.Exec
@@ -0,0 +1,9 @@
+// +build windows
+
+package upgrade
+
+import "errors"
+
+func execBinary(argv0 string, argv []string, envv []string) error {
+	return errors.New("executing the upgrade binary is not supported on windows")
+}
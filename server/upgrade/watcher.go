@@ -0,0 +1,121 @@
+package upgrade
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+// Config configures the upgrade watcher of a node
+type Config struct {
+	// InfoFile is the path of the upgrade-info file written as the switch height approaches
+	InfoFile string
+	// BlocksBefore is how many blocks before the switch height the watcher acts
+	BlocksBefore uint64
+	// Binary is the path of the replacement binary
+	Binary string
+	// AutoDownload fetches the replacement binary from the url of the proposal when it is missing
+	AutoDownload bool
+	// Exec replaces the running node with the replacement binary once its checksum is verified
+	Exec bool
+}
+
+// UpgradeConfigReader returns the upgrade scheduled in the committed state
+type UpgradeConfigReader func() (sdk.UpgradeConfig, bool)
+
+// Application is the application the watcher follows the commits of
+type Application interface {
+	abci.Application
+	LastBlockHeight() int64
+}
+
+// Watcher wraps an application and acts on the upgrade scheduled in its state after each commit
+type Watcher struct {
+	Application
+
+	config        Config
+	upgradeConfig UpgradeConfigReader
+	logger        log.Logger
+	exec          func(argv0 string, argv []string, envv []string) error
+
+	// proposal ids plus one, zero stands for none
+	written uint64 // upgrade the info file was written for
+	running uint64 // upgrade the running binary is the replacement binary of
+}
+
+// NewWatcher creates a new upgrade watcher of the application
+func NewWatcher(app Application, upgradeConfig UpgradeConfigReader, config Config, logger log.Logger) *Watcher {
+	return &Watcher{
+		Application:   app,
+		config:        config,
+		upgradeConfig: upgradeConfig,
+		logger:        logger.With("module", "upgrade-watcher"),
+		exec:          execBinary,
+	}
+}
+
+// Commit implements the ABCI interface. Like the halt height, the replacement binary takes
+// over right after the state is committed, tendermint replays the block on restart.
+func (w *Watcher) Commit() abci.ResponseCommit {
+	res := w.Application.Commit()
+	if err := w.Check(w.LastBlockHeight()); err != nil {
+		w.logger.Error("failed to apply the scheduled upgrade", "err", err)
+	}
+	return res
+}
+
+// Check writes the upgrade-info file and executes the replacement binary once the switch
+// height of the scheduled upgrade is within BlocksBefore blocks of height
+func (w *Watcher) Check(height int64) error {
+	upgradeConfig, found := w.upgradeConfig()
+	if !found || uint64(height)+w.config.BlocksBefore < upgradeConfig.Protocol.Height {
+		return nil
+	}
+
+	id := upgradeConfig.ProposalID + 1
+	if w.written != id {
+		if err := WriteInfo(w.config.InfoFile, NewInfo(upgradeConfig)); err != nil {
+			return err
+		}
+		w.written = id
+		w.logger.Info("wrote the upgrade info", "file", w.config.InfoFile, "version", upgradeConfig.Protocol.Version,
+			"height", upgradeConfig.Protocol.Height)
+	}
+
+	if !w.config.Exec || w.running == id {
+		return nil
+	}
+
+	platform := fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+	binary, found := PlatformBinary(upgradeConfig.Protocol.Binaries, platform)
+	if !found {
+		return fmt.Errorf("no %s binary in the upgrade to version %d", platform, upgradeConfig.Protocol.Version)
+	}
+
+	// the replacement binary restarts with the same configuration and finds itself running
+	if executable, err := os.Executable(); err == nil && VerifyFile(executable, binary) == nil {
+		w.running = id
+		return nil
+	}
+
+	if len(w.config.Binary) == 0 {
+		return fmt.Errorf("no replacement binary configured for the upgrade to version %d", upgradeConfig.Protocol.Version)
+	}
+	if _, err := os.Stat(w.config.Binary); os.IsNotExist(err) && w.config.AutoDownload {
+		w.logger.Info("downloading the upgrade binary", "url", binary.URL, "file", w.config.Binary)
+		if err := Download(binary, w.config.Binary); err != nil {
+			return err
+		}
+	}
+	if err := VerifyFile(w.config.Binary, binary); err != nil {
+		return err
+	}
+
+	w.logger.Info("executing the upgrade binary", "file", w.config.Binary, "version", upgradeConfig.Protocol.Version)
+	return w.exec(w.config.Binary, append([]string{w.config.Binary}, os.Args[1:]...), os.Environ())
+}
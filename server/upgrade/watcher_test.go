@@ -0,0 +1,136 @@
+package upgrade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/Dipper-Labs/Dipper-Protocol/types"
+)
+
+type mockApp struct {
+	abci.BaseApplication
+	height int64
+}
+
+func (app *mockApp) LastBlockHeight() int64 { return app.height }
+
+func (app *mockApp) Commit() abci.ResponseCommit {
+	app.height++
+	return abci.ResponseCommit{}
+}
+
+func testBinary(t *testing.T, content []byte, url string) sdk.UpgradeBinary {
+	sum := sha256.Sum256(content)
+	binary := sdk.UpgradeBinary{
+		Platform: runtime.GOOS + "/" + runtime.GOARCH,
+		URL:      url,
+		Checksum: "sha256:" + hex.EncodeToString(sum[:]),
+	}
+	require.NoError(t, binary.ValidateBasic())
+	return binary
+}
+
+func TestDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "upgrade")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	content := []byte("dipd v1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	file := filepath.Join(dir, "bin", "dipd")
+	binary := testBinary(t, content, server.URL+"/dipd")
+	require.NoError(t, Download(binary, file))
+	require.NoError(t, VerifyFile(file, binary))
+
+	fi, err := os.Stat(file)
+	require.NoError(t, err)
+	require.NotZero(t, fi.Mode()&0100)
+
+	// a file url works offline
+	local := filepath.Join(dir, "dipd-local")
+	binary.URL = "file://" + file
+	require.NoError(t, Download(binary, local))
+	require.NoError(t, VerifyFile(local, binary))
+
+	// the binary is not kept when the checksum does not match
+	corrupted := filepath.Join(dir, "dipd-corrupted")
+	binary = testBinary(t, []byte("dipd v2"), server.URL+"/dipd")
+	require.Error(t, Download(binary, corrupted))
+	_, err = os.Stat(corrupted)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "upgrade")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	content := []byte("dipd v1")
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer server.Close()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "dipd-release"), content, 0644))
+
+	pd := sdk.NewProtocolDefinition(1, "https://github.com/Dipper-Labs/Dipper-Protocol/releases", 20, sdk.NewDecWithPrec(8, 1))
+	pd.Binaries = []sdk.UpgradeBinary{testBinary(t, content, server.URL+"/dipd-release")}
+	pd.Info = `{"notes":"upgrade"}`
+	upgradeConfig := sdk.NewUpgradeConfig(3, pd)
+
+	app := &mockApp{height: 5}
+	config := Config{
+		InfoFile:     filepath.Join(dir, "data", "upgrade-info.json"),
+		BlocksBefore: 10,
+		Binary:       filepath.Join(dir, "dipd"),
+		AutoDownload: true,
+		Exec:         true,
+	}
+	w := NewWatcher(app, func() (sdk.UpgradeConfig, bool) { return upgradeConfig, true }, config, log.NewNopLogger())
+
+	var executed []string
+	w.exec = func(argv0 string, argv []string, envv []string) error {
+		executed = append(executed, argv0)
+		return nil
+	}
+
+	// too early
+	w.Commit()
+	_, err = os.Stat(config.InfoFile)
+	require.True(t, os.IsNotExist(err))
+	require.Empty(t, executed)
+
+	app.height = 9
+	w.Commit()
+
+	bz, err := ioutil.ReadFile(config.InfoFile)
+	require.NoError(t, err)
+	var info Info
+	require.NoError(t, json.Unmarshal(bz, &info))
+	require.Equal(t, uint64(3), info.ProposalID)
+	require.Equal(t, pd.Version, info.Version)
+	require.Equal(t, pd.Height, info.Height)
+	require.Equal(t, pd.Binaries, info.Binaries)
+	require.JSONEq(t, pd.Info, string(info.Info))
+
+	require.NoError(t, VerifyFile(config.Binary, pd.Binaries[0]))
+	require.Equal(t, []string{config.Binary}, executed)
+
+	// a replacement binary not matching the checksum is not executed
+	require.NoError(t, ioutil.WriteFile(config.Binary, []byte("dipd v2"), 0755))
+	require.Error(t, w.Check(app.height))
+	require.Len(t, executed, 1)
+}
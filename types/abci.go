@@ -23,4 +23,4 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
 
-type PostEndBlockHandler func(res *abci.ResponseEndBlock)
+type PostEndBlockHandler func(ctx Context, res *abci.ResponseEndBlock)
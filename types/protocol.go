@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/Dipper-Labs/Dipper-Protocol/codec"
 )
@@ -19,10 +21,65 @@ var (
 )
 
 type ProtocolDefinition struct {
-	Version   uint64 `json:"version"`
-	Software  string `json:"software"`
-	Height    uint64 `json:"height"`
-	Threshold Dec    `json:"threshold"`
+	Version   uint64          `json:"version"`
+	Software  string          `json:"software"`
+	Height    uint64          `json:"height"`
+	Threshold Dec             `json:"threshold"`
+	Binaries  []UpgradeBinary `json:"binaries,omitempty"`
+	Info      string          `json:"info,omitempty"`
+}
+
+// UpgradeBinary is the binary of a protocol version built for a platform, e.g. linux/amd64,
+// its checksum is prefixed with the hash algorithm, e.g. sha256:<hex digest>
+type UpgradeBinary struct {
+	Platform string `json:"platform"`
+	URL      string `json:"url"`
+	Checksum string `json:"checksum"`
+}
+
+// checksum algorithms by the length of their hex digest
+var checksumHexLengths = map[string]int{
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// ValidateBasic checks the platform is os/arch and the checksum a supported algorithm with a digest
+func (ub UpgradeBinary) ValidateBasic() error {
+	platform := strings.Split(ub.Platform, "/")
+	if len(platform) != 2 || len(platform[0]) == 0 || len(platform[1]) == 0 {
+		return fmt.Errorf("invalid platform %q, expected os/arch", ub.Platform)
+	}
+	if len(strings.TrimSpace(ub.URL)) == 0 {
+		return fmt.Errorf("no url for the %s binary", ub.Platform)
+	}
+
+	algo, digest, err := ub.ParseChecksum()
+	if err != nil {
+		return err
+	}
+	if len(digest)*2 != checksumHexLengths[algo] {
+		return fmt.Errorf("invalid %s checksum of the %s binary", algo, ub.Platform)
+	}
+	return nil
+}
+
+// ParseChecksum returns the hash algorithm and the digest of the checksum
+func (ub UpgradeBinary) ParseChecksum() (algo string, digest []byte, err error) {
+	fields := strings.Split(ub.Checksum, ":")
+	if len(fields) != 2 {
+		return "", nil, fmt.Errorf("invalid checksum %q, expected algorithm:hex digest", ub.Checksum)
+	}
+
+	algo = strings.ToLower(fields[0])
+	if _, ok := checksumHexLengths[algo]; !ok {
+		return "", nil, fmt.Errorf("unsupported checksum algorithm %q", fields[0])
+	}
+
+	digest, err = hex.DecodeString(fields[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid checksum digest %q: %s", fields[1], err)
+	}
+	return algo, digest, nil
 }
 
 type UpgradeConfig struct {
@@ -38,10 +95,10 @@ func (uc UpgradeConfig) String() string {
 
 func NewProtocolDefinition(version uint64, software string, height uint64, threshold Dec) ProtocolDefinition {
 	return ProtocolDefinition{
-		version,
-		software,
-		height,
-		threshold,
+		Version:   version,
+		Software:  software,
+		Height:    height,
+		Threshold: threshold,
 	}
 }
 